@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// MockRegion is the Region of API clients that are answered by a MockHandler.
+	MockRegion = "us-west-2" //lintignore:AWSAT003
+)
+
+// MockHandler answers an API operation without sending a request.
+// input is the operation's input, e.g. *networkfirewall.DescribeFirewallPolicyInput, and
+// the returned value is used as the operation's output.
+type MockHandler func(ctx context.Context, input any) (any, error)
+
+// MockAPIOptions returns API client options that answer every operation with handler.
+// Use it to unit test code that calls AWS APIs, e.g.
+//
+//	conn := networkfirewall.New(networkfirewall.Options{
+//		Region:     acctest.MockRegion,
+//		APIOptions: acctest.MockAPIOptions(handler),
+//	})
+func MockAPIOptions(handler MockHandler) []func(*middleware.Stack) error {
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("mockResponse", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				output, err := handler(ctx, in.Parameters)
+
+				return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, err
+			}), middleware.Before)
+		},
+	}
+}
@@ -119,6 +119,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"servicecatalog_accept_language": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default language code for AWS Service Catalog resources and data sources that do not set `accept_language`. Valid values are `en` (English), `jp` (Japanese) and `zh` (Chinese). Specific to the AWS Service Catalog service.",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
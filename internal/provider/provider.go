@@ -188,6 +188,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"servicecatalog_accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"en", "jp", "zh"}, false),
+				Description: "Default language code for AWS Service Catalog resources and data sources that do not set `accept_language`. " +
+					"Valid values are `en` (English), `jp` (Japanese) and `zh` (Chinese). Specific to the AWS Service Catalog service.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -490,6 +497,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
+		ServiceCatalogAcceptLanguage:   d.Get("servicecatalog_accept_language").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
@@ -192,7 +192,6 @@ var (
 	FindSpotFleetRequests                                      = findSpotFleetRequests
 	FindSpotInstanceRequestByID                                = findSpotInstanceRequestByID
 	FindSubnetCIDRReservationBySubnetIDAndReservationID        = findSubnetCIDRReservationBySubnetIDAndReservationID
-	FindSubnets                                                = findSubnets
 	FindTag                                                    = findTag
 	FindTrafficMirrorFilterByID                                = findTrafficMirrorFilterByID
 	FindTrafficMirrorFilterRuleByTwoPartKey                    = findTrafficMirrorFilterRuleByTwoPartKey
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+const (
+	statelessActionDrop         = "aws:drop"
+	statelessActionForwardToSFE = "aws:forward_to_sfe"
+	statelessActionPass         = "aws:pass"
+)
+
+func statelessAction_Values() []string {
+	return []string{
+		statelessActionDrop,
+		statelessActionForwardToSFE,
+		statelessActionPass,
+	}
+}
+
+const (
+	statefulDefaultActionAlertEstablished = "aws:alert_established"
+	statefulDefaultActionAlertStrict      = "aws:alert_strict"
+	statefulDefaultActionDropEstablished  = "aws:drop_established"
+	statefulDefaultActionDropStrict       = "aws:drop_strict"
+)
+
+func statefulDefaultAction_Values() []string {
+	return []string{
+		statefulDefaultActionAlertEstablished,
+		statefulDefaultActionAlertStrict,
+		statefulDefaultActionDropEstablished,
+		statefulDefaultActionDropStrict,
+	}
+}
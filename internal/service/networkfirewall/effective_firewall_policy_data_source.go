@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Effective Firewall Policy")
+func newEffectiveFirewallPolicyDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &effectiveFirewallPolicyDataSource{}, nil
+}
+
+type effectiveFirewallPolicyDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*effectiveFirewallPolicyDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_networkfirewall_effective_firewall_policy"
+}
+
+func (d *effectiveFirewallPolicyDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot(names.AttrName)),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *effectiveFirewallPolicyDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data effectiveFirewallPolicyDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().NetworkFirewallClient(ctx)
+
+	input := &networkfirewall.DescribeFirewallPolicyInput{}
+	if !data.FirewallPolicyARN.IsNull() {
+		input.FirewallPolicyArn = data.FirewallPolicyARN.ValueStringPointer()
+	}
+	if !data.FirewallPolicyName.IsNull() {
+		input.FirewallPolicyName = data.FirewallPolicyName.ValueStringPointer()
+	}
+
+	output, err := findFirewallPolicy(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading NetworkFirewall Firewall Policy", err.Error())
+
+		return
+	}
+
+	policyARN := aws.ToString(output.FirewallPolicyResponse.FirewallPolicyArn)
+	document, err := expandEffectiveFirewallPolicy(ctx, conn, output.FirewallPolicy)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading NetworkFirewall Firewall Policy (%s) rule group metadata", policyARN), err.Error())
+
+		return
+	}
+
+	v, err := marshalDocument(document)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("serializing NetworkFirewall Firewall Policy (%s)", policyARN), err.Error())
+
+		return
+	}
+
+	data.FirewallPolicyARN = fwtypes.ARNValue(policyARN)
+	data.FirewallPolicyName = fwflex.StringToFramework(ctx, output.FirewallPolicyResponse.FirewallPolicyName)
+	data.ID = types.StringValue(policyARN)
+	data.JSON = types.StringValue(string(v))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// expandEffectiveFirewallPolicy returns the firewall policy with the metadata of each referenced rule group,
+// including AWS managed rule groups, resolved inline.
+func expandEffectiveFirewallPolicy(ctx context.Context, conn *networkfirewall.Client, apiObject *awstypes.FirewallPolicy) (*effectiveFirewallPolicy, error) {
+	if apiObject == nil {
+		return nil, nil
+	}
+
+	document := &effectiveFirewallPolicy{
+		FirewallPolicy: apiObject,
+	}
+
+	for _, v := range apiObject.StatefulRuleGroupReferences {
+		metadata, err := findEffectiveRuleGroupMetadata(ctx, conn, aws.ToString(v.ResourceArn))
+
+		if err != nil {
+			return nil, err
+		}
+
+		document.StatefulRuleGroupReferences = append(document.StatefulRuleGroupReferences, effectiveStatefulRuleGroupReference{
+			StatefulRuleGroupReference: v,
+			Metadata:                   metadata,
+		})
+	}
+
+	for _, v := range apiObject.StatelessRuleGroupReferences {
+		metadata, err := findEffectiveRuleGroupMetadata(ctx, conn, aws.ToString(v.ResourceArn))
+
+		if err != nil {
+			return nil, err
+		}
+
+		document.StatelessRuleGroupReferences = append(document.StatelessRuleGroupReferences, effectiveStatelessRuleGroupReference{
+			StatelessRuleGroupReference: v,
+			Metadata:                    metadata,
+		})
+	}
+
+	return document, nil
+}
+
+func findEffectiveRuleGroupMetadata(ctx context.Context, conn *networkfirewall.Client, arn string) (*effectiveRuleGroupMetadata, error) {
+	output, err := findRuleGroupMetadata(ctx, conn, &networkfirewall.DescribeRuleGroupMetadataInput{
+		RuleGroupArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading NetworkFirewall Rule Group (%s) metadata: %w", arn, err)
+	}
+
+	return &effectiveRuleGroupMetadata{
+		Capacity:            output.Capacity,
+		Description:         output.Description,
+		RuleGroupName:       output.RuleGroupName,
+		StatefulRuleOptions: output.StatefulRuleOptions,
+		Type:                output.Type,
+	}, nil
+}
+
+// The embedded policy's rule group references are shadowed by the enriched ones when marshaled to JSON.
+type effectiveFirewallPolicy struct {
+	*awstypes.FirewallPolicy
+	StatefulRuleGroupReferences  []effectiveStatefulRuleGroupReference
+	StatelessRuleGroupReferences []effectiveStatelessRuleGroupReference
+}
+
+type effectiveStatefulRuleGroupReference struct {
+	awstypes.StatefulRuleGroupReference
+	Metadata *effectiveRuleGroupMetadata
+}
+
+type effectiveStatelessRuleGroupReference struct {
+	awstypes.StatelessRuleGroupReference
+	Metadata *effectiveRuleGroupMetadata
+}
+
+type effectiveRuleGroupMetadata struct {
+	Capacity            *int32
+	Description         *string
+	RuleGroupName       *string
+	StatefulRuleOptions *awstypes.StatefulRuleOptions
+	Type                awstypes.RuleGroupType
+}
+
+type effectiveFirewallPolicyDataSourceModel struct {
+	FirewallPolicyARN  fwtypes.ARN  `tfsdk:"arn"`
+	FirewallPolicyName types.String `tfsdk:"name"`
+	ID                 types.String `tfsdk:"id"`
+	JSON               types.String `tfsdk:"json"`
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallEffectiveFirewallPolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+	dataSourceName := "data.aws_networkfirewall_effective_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectiveFirewallPolicyDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"StatefulRuleGroupReferences":\[\{"Metadata":\{"Capacity":[1-9][0-9]*,.*"RuleGroupName":"MalwareDomainsActionOrder",.*"Type":"STATEFUL"\},"ResourceArn":"arn:[^"]+:aws-managed:stateful-rulegroup/MalwareDomainsActionOrder"\}\]`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"Metadata":\{"Capacity":100,"RuleGroupName":"`+rName+`","Type":"STATELESS"\}`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"StatelessDefaultActions":\["aws:forward_to_sfe"\]`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallEffectiveFirewallPolicyDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+	dataSourceName := "data.aws_networkfirewall_effective_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectiveFirewallPolicyDataSourceConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectiveFirewallPolicyDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:drop"]
+
+            match_attributes {
+              source {
+                address_definition = "1.2.3.4/32"
+              }
+
+              destination {
+                address_definition = "124.1.1.5/32"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_default_actions          = ["aws:forward_to_sfe"]
+    stateless_fragment_default_actions = ["aws:forward_to_sfe"]
+
+    stateful_rule_group_reference {
+      resource_arn = "arn:${data.aws_partition.current.partition}:network-firewall:${data.aws_region.current.name}:aws-managed:stateful-rulegroup/MalwareDomainsActionOrder"
+    }
+
+    stateless_rule_group_reference {
+      priority     = 1
+      resource_arn = aws_networkfirewall_rule_group.test.arn
+    }
+  }
+}
+`, rName)
+}
+
+func testAccEffectiveFirewallPolicyDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEffectiveFirewallPolicyDataSourceConfig_base(rName), `
+data "aws_networkfirewall_effective_firewall_policy" "test" {
+  arn = aws_networkfirewall_firewall_policy.test.arn
+}
+`)
+}
+
+func testAccEffectiveFirewallPolicyDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccEffectiveFirewallPolicyDataSourceConfig_base(rName), `
+data "aws_networkfirewall_effective_firewall_policy" "test" {
+  name = aws_networkfirewall_firewall_policy.test.name
+}
+`)
+}
@@ -18,4 +18,29 @@ var (
 	FindResourcePolicyByARN             = findResourcePolicyByARN
 	FindRuleGroupByARN                  = findRuleGroupByARN
 	FindTLSInspectionConfigurationByARN = findTLSInspectionConfigurationByARN
+
+	ExpandEncryptionConfiguration                       = expandEncryptionConfiguration
+	FilterUnassociatedTLSInspectionConfigurations       = filterUnassociatedTLSInspectionConfigurations
+	TLSInspectionConfigurationsDescribeConcurrency      = tlsInspectionConfigurationsDescribeConcurrency
+	SuppressEquivalentSuricataRules                     = suppressEquivalentSuricataRules
+	FlattenDescribeTLSInspectionConfigurationOutput     = flattenDescribeTLSInspectionConfigurationOutput
+	MarshalDocument                                     = marshalDocument
+	TLSInspectionConfigurationDocument                  = tlsInspectionConfigurationDocument
+	SortServerCertificateScopes                         = sortServerCertificateScopes
+	ValidateRuleGroupDocument                           = validateRuleGroupDocument
+	ValidateFirewallPolicyDocument                      = validateFirewallPolicyDocument
+	UpdateFirewallPolicy                                = updateFirewallPolicy
+	ValidateStatefulRuleGroupRuleOrders                 = validateStatefulRuleGroupRuleOrders
+	UpdateTags                                          = updateTags
+	WaitTLSInspectionConfigurationCreated               = waitTLSInspectionConfigurationCreated
+	FindNotDeletingTLSInspectionConfigurationByARN      = findNotDeletingTLSInspectionConfigurationByARN
+	FirewallEndpointIDs                                 = firewallEndpointIDs
+	FlattenFirewallPolicy                               = flattenFirewallPolicy
+	FlattenFirewallPolicyTLSInspectionConfigurationName = flattenFirewallPolicyTLSInspectionConfigurationName
+)
+
+type (
+	EncryptionConfigurationModel            = encryptionConfigurationModel
+	TLSInspectionConfigurationModel         = tlsInspectionConfigurationModel
+	TLSInspectionConfigurationResourceModel = tlsInspectionConfigurationResourceModel
 )
@@ -5,12 +5,17 @@ package networkfirewall
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -20,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -49,6 +55,10 @@ func resourceFirewall() *schema.Resource {
 			customdiff.ComputedIf("firewall_status", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("subnet_mapping")
 			}),
+			customdiff.ComputedIf("tls_inspection_configuration_arn", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("firewall_policy_arn")
+			}),
+			customizeDiffSubnetMappingAvailabilityZones,
 			verify.SetTagsDiff,
 		),
 
@@ -142,6 +152,10 @@ func resourceFirewall() *schema.Resource {
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				"tls_inspection_configuration_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"update_token": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -237,6 +251,11 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("subnet_mapping", flattenSubnetMappings(firewall.SubnetMappings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting subnet_mapping: %s", err)
 	}
+	tlsInspectionConfigurationARN, err := findFirewallTLSInspectionConfigurationARN(ctx, conn, aws.ToString(firewall.FirewallPolicyArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall (%s) TLS inspection configuration: %s", d.Id(), err)
+	}
+	d.Set("tls_inspection_configuration_arn", tlsInspectionConfigurationARN)
 	d.Set("update_token", output.UpdateToken)
 	d.Set(names.AttrVPCID, firewall.VpcId)
 
@@ -406,8 +425,11 @@ func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
 
+	// The firewall and its VPC endpoints share the delete timeout.
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutDelete))
+
 	log.Printf("[DEBUG] Deleting NetworkFirewall Firewall: %s", d.Id())
-	_, err := conn.DeleteFirewall(ctx, &networkfirewall.DeleteFirewallInput{
+	output, err := conn.DeleteFirewall(ctx, &networkfirewall.DeleteFirewallInput{
 		FirewallArn: aws.String(d.Id()),
 	})
 
@@ -416,16 +438,97 @@ func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if err != nil {
+		// The Plugin SDK does not call CustomizeDiff when planning a destroy, so delete protection can only be reported here.
+		if d.Get("delete_protection").(bool) {
+			return sdkdiag.AppendErrorf(diags, "deleting NetworkFirewall Firewall (%s): delete_protection is enabled, set it to false and apply before destroying: %s", d.Id(), err)
+		}
+
 		return sdkdiag.AppendErrorf(diags, "deleting NetworkFirewall Firewall (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitFirewallDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete), d.Id()); err != nil {
+	if _, err := waitFirewallDeleted(ctx, conn, deadline.Remaining(), d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) delete: %s", d.Id(), err)
 	}
 
+	// The firewall's VPC endpoints can still be deleting after the firewall is gone,
+	// which would block deleting the subnets and VPC in the same destroy.
+	ec2Conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	for _, endpointID := range firewallEndpointIDs(output.FirewallStatus) {
+		if _, err := tfec2.WaitVPCEndpointDeleted(ctx, ec2Conn, endpointID, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) VPC endpoint (%s) delete: %s", d.Id(), endpointID, err)
+		}
+	}
+
 	return diags
 }
 
+// firewallEndpointIDs returns the IDs of the VPC endpoints of a firewall in each of its Availability Zones.
+func firewallEndpointIDs(apiObject *awstypes.FirewallStatus) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	var endpointIDs []string
+
+	for _, syncState := range apiObject.SyncStates {
+		if syncState.Attachment == nil {
+			continue
+		}
+
+		if v := aws.ToString(syncState.Attachment.EndpointId); v != "" {
+			endpointIDs = append(endpointIDs, v)
+		}
+	}
+
+	slices.Sort(endpointIDs)
+
+	return endpointIDs
+}
+
+// customizeDiffSubnetMappingAvailabilityZones ensures that each subnet_mapping is in a distinct Availability Zone.
+// NetworkFirewall supports only one subnet per Availability Zone and otherwise fails at apply time.
+func customizeDiffSubnetMappingAvailabilityZones(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("subnet_mapping") {
+		return nil
+	}
+
+	// Subnet IDs that are not yet known (e.g. subnets created in the same apply) are ignored.
+	subnetIDs := expandSubnetMappingIDs(diff.Get("subnet_mapping").(*schema.Set).List())
+
+	if len(subnetIDs) < 2 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	subnets, err := tfec2.FindSubnets(ctx, conn, &ec2.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	})
+
+	// Let the NetworkFirewall API report any missing subnets.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Subnets (%s): %w", strings.Join(subnetIDs, ", "), err)
+	}
+
+	subnetIDsByAZ := make(map[string]string)
+	for _, subnet := range subnets {
+		az, subnetID := aws.ToString(subnet.AvailabilityZone), aws.ToString(subnet.SubnetId)
+
+		if v, ok := subnetIDsByAZ[az]; ok {
+			return fmt.Errorf("subnet_mapping: subnets %s and %s are both in Availability Zone %s; NetworkFirewall supports only one subnet per Availability Zone", v, subnetID, az)
+		}
+
+		subnetIDsByAZ[az] = subnetID
+	}
+
+	return nil
+}
+
 func findFirewall(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.DescribeFirewallInput) (*networkfirewall.DescribeFirewallOutput, error) {
 	output, err := conn.DescribeFirewall(ctx, input)
 
@@ -455,6 +558,33 @@ func findFirewallByARN(ctx context.Context, conn *networkfirewall.Client, arn st
 	return findFirewall(ctx, conn, input)
 }
 
+// findFirewallTLSInspectionConfigurationARN returns the ARN of the TLS inspection configuration
+// referenced by the specified firewall policy, or an empty string if there is none.
+// The firewall policy may be shared from another account or not readable by the caller,
+// so a firewall policy that cannot be described is treated as having no TLS inspection configuration.
+func findFirewallTLSInspectionConfigurationARN(ctx context.Context, conn *networkfirewall.Client, policyARN string) (string, error) {
+	if policyARN == "" {
+		return "", nil
+	}
+
+	output, err := findFirewallPolicyByARN(ctx, conn, policyARN)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+		log.Printf("[WARN] Unable to read NetworkFirewall Firewall Policy (%s) TLS inspection configuration: %s", policyARN, err)
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output.FirewallPolicy == nil {
+		return "", nil
+	}
+
+	return aws.ToString(output.FirewallPolicy.TLSInspectionConfigurationArn), nil
+}
+
 func statusFirewall(ctx context.Context, conn *networkfirewall.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findFirewallByARN(ctx, conn, arn)
@@ -174,6 +174,10 @@ func dataSourceFirewall() *schema.Resource {
 				},
 			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			"tls_inspection_configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"update_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -222,6 +226,11 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("subnet_mapping", flattenDataSourceSubnetMappings(firewall.SubnetMappings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting subnet_mappings: %s", err)
 	}
+	tlsInspectionConfigurationARN, err := findFirewallTLSInspectionConfigurationARN(ctx, conn, aws.ToString(firewall.FirewallPolicyArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall (%s) TLS inspection configuration: %s", d.Id(), err)
+	}
+	d.Set("tls_inspection_configuration_arn", tlsInspectionConfigurationARN)
 	d.Set("update_token", output.UpdateToken)
 	d.Set(names.AttrVPCID, firewall.VpcId)
 
@@ -56,6 +56,7 @@ func TestAccNetworkFirewallFirewallDataSource_arn(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "subnet_mapping.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "subnet_mapping.*.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "tls_inspection_configuration_arn", ""),
 					resource.TestCheckResourceAttrSet(dataSourceName, "update_token"),
 				),
 			},
@@ -104,6 +105,7 @@ func TestAccNetworkFirewallFirewallDataSource_name(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "subnet_mapping.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "subnet_mapping.*.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "tls_inspection_configuration_arn", ""),
 					resource.TestCheckResourceAttrSet(dataSourceName, "update_token"),
 				),
 			},
@@ -152,6 +154,7 @@ func TestAccNetworkFirewallFirewallDataSource_arnandname(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "subnet_mapping.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "subnet_mapping.*.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "tls_inspection_configuration_arn", ""),
 					resource.TestCheckResourceAttrSet(dataSourceName, "update_token"),
 				),
 			},
@@ -159,6 +162,35 @@ func TestAccNetworkFirewallFirewallDataSource_arnandname(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallFirewallDataSource_tlsInspectionConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_firewall.test"
+	dataSourceName := "data.aws_networkfirewall_firewall.test"
+	policyResourceName := "aws_networkfirewall_firewall_policy.test"
+	tlsInspectionConfigurationResourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDataSourceConfig_tlsInspectionConfiguration(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration_arn", policyResourceName, "firewall_policy.0.tls_inspection_configuration_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration_arn", tlsInspectionConfigurationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tls_inspection_configuration_arn", policyResourceName, "firewall_policy.0.tls_inspection_configuration_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tls_inspection_configuration_arn", tlsInspectionConfigurationResourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccFirewallDataSourceDependenciesConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
@@ -262,3 +294,50 @@ data "aws_networkfirewall_firewall" "test" {
 }
 `, rName))
 }
+
+func testAccFirewallDataSourceConfig_tlsInspectionConfiguration(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(
+		testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, commonName, certificateDomainName),
+		fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "192.168.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test.id
+  }
+}
+
+data "aws_networkfirewall_firewall" "test" {
+  arn = aws_networkfirewall_firewall.test.arn
+}
+`, rName))
+}
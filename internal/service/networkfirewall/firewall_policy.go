@@ -5,13 +5,17 @@ package networkfirewall
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -38,7 +42,11 @@ func resourceFirewallPolicy() *schema.Resource {
 		DeleteWithoutTimeout: resourceFirewallPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceFirewallPolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		SchemaFunc: func() map[string]*schema.Schema {
@@ -47,6 +55,14 @@ func resourceFirewallPolicy() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"consumed_stateful_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"consumed_stateless_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				names.AttrDescription: {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -57,138 +73,7 @@ func resourceFirewallPolicy() *schema.Resource {
 					Required: true,
 					MaxItems: 1,
 					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"policy_variables": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"rule_variables": {
-											Type:     schema.TypeSet,
-											Optional: true,
-											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													"ip_set": {
-														Type:     schema.TypeList,
-														Required: true,
-														MaxItems: 1,
-														Elem: &schema.Resource{
-															Schema: map[string]*schema.Schema{
-																"definition": {
-																	Type:     schema.TypeSet,
-																	Required: true,
-																	Elem:     &schema.Schema{Type: schema.TypeString},
-																},
-															},
-														},
-													},
-													names.AttrKey: {
-														Type:     schema.TypeString,
-														Required: true,
-														ValidateFunc: validation.All(
-															validation.StringLenBetween(1, 32),
-															validation.StringMatch(regexache.MustCompile(`^[A-Za-z]`), "must begin with alphabetic character"),
-															validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric and underscore characters"),
-														),
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-							"stateful_default_actions": {
-								Type:     schema.TypeSet,
-								Optional: true,
-								Elem:     &schema.Schema{Type: schema.TypeString},
-							},
-							"stateful_engine_options": {
-								Type:     schema.TypeList,
-								MaxItems: 1,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"rule_order": {
-											Type:             schema.TypeString,
-											Optional:         true,
-											ValidateDiagFunc: enum.Validate[awstypes.RuleOrder](),
-										},
-										"stream_exception_policy": {
-											Type:             schema.TypeString,
-											Optional:         true,
-											ValidateDiagFunc: enum.Validate[awstypes.StreamExceptionPolicy](),
-										},
-									},
-								},
-							},
-							"stateful_rule_group_reference": {
-								Type:     schema.TypeSet,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"override": {
-											Type:     schema.TypeList,
-											MaxItems: 1,
-											Optional: true,
-											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													names.AttrAction: {
-														Type:             schema.TypeString,
-														Optional:         true,
-														ValidateDiagFunc: enum.Validate[awstypes.OverrideAction](),
-													},
-												},
-											},
-										},
-										names.AttrPriority: {
-											Type:         schema.TypeInt,
-											Optional:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-										names.AttrResourceARN: {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: verify.ValidARN,
-										},
-									},
-								},
-							},
-							"stateless_custom_action": customActionSchema(),
-							"stateless_default_actions": {
-								Type:     schema.TypeSet,
-								Required: true,
-								Elem:     &schema.Schema{Type: schema.TypeString},
-							},
-							"stateless_fragment_default_actions": {
-								Type:     schema.TypeSet,
-								Required: true,
-								Elem:     &schema.Schema{Type: schema.TypeString},
-							},
-							"stateless_rule_group_reference": {
-								Type:     schema.TypeSet,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										names.AttrPriority: {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntAtLeast(1),
-										},
-										names.AttrResourceARN: {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: verify.ValidARN,
-										},
-									},
-								},
-							},
-							"tls_inspection_configuration_arn": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								ValidateFunc: verify.ValidARN,
-							},
-						},
+						Schema: firewallPolicyResourceSchema(),
 					},
 				},
 				names.AttrName: {
@@ -211,11 +96,175 @@ func resourceFirewallPolicy() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("firewall_policy.0.stateful_engine_options.0.rule_order", d)
 			},
+			// The capacity consumed by the policy changes with its rule group references.
+			customdiff.ComputedIf("consumed_stateful_capacity", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("firewall_policy.0.stateful_rule_group_reference")
+			}),
+			customdiff.ComputedIf("consumed_stateless_capacity", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("firewall_policy.0.stateless_rule_group_reference")
+			}),
+			customizeDiffStatefulRuleGroupRuleOrder,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+// firewallPolicySchema returns the schema of the firewall_policy block.
+func firewallPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"policy_variables": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule_variables": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"ip_set": {
+									Type:     schema.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"definition": {
+												Type:     schema.TypeSet,
+												Required: true,
+												Elem: &schema.Schema{
+													Type:         schema.TypeString,
+													ValidateFunc: validCIDRBlock,
+												},
+											},
+										},
+									},
+								},
+								names.AttrKey: {
+									Type:     schema.TypeString,
+									Required: true,
+									ValidateFunc: validation.All(
+										validation.StringLenBetween(1, 32),
+										validation.StringMatch(regexache.MustCompile(`^[A-Za-z]`), "must begin with alphabetic character"),
+										validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric and underscore characters"),
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"stateful_default_actions": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"stateful_engine_options": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule_order": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.RuleOrder](),
+					},
+					"stream_exception_policy": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.StreamExceptionPolicy](),
+					},
+				},
+			},
+		},
+		"stateful_rule_group_reference": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"override": {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								names.AttrAction: {
+									Type:             schema.TypeString,
+									Optional:         true,
+									ValidateDiagFunc: enum.Validate[awstypes.OverrideAction](),
+								},
+							},
+						},
+					},
+					names.AttrPriority: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					names.AttrResourceARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"stateless_custom_action": customActionSchema(),
+		"stateless_default_actions": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"stateless_fragment_default_actions": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"stateless_rule_group_reference": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrPriority: {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					names.AttrResourceARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"tls_inspection_configuration_arn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+	}
+}
+
+// firewallPolicyResourceSchema returns the schema of the resource's firewall_policy block.
+// A TLS inspection configuration can also be referenced by name, which is resolved to its ARN on apply.
+func firewallPolicyResourceSchema() map[string]*schema.Schema {
+	s := firewallPolicySchema()
+
+	s["tls_inspection_configuration_arn"].ConflictsWith = []string{"firewall_policy.0.tls_inspection_configuration_name"}
+	s["tls_inspection_configuration_name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ValidateFunc:  validation.StringLenBetween(1, 128),
+		ConflictsWith: []string{"firewall_policy.0.tls_inspection_configuration_arn"},
+	}
+
+	return s
+}
+
 func resourceFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
@@ -227,6 +276,10 @@ func resourceFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 		Tags:               getTagsIn(ctx),
 	}
 
+	if err := resolveFirewallPolicyTLSInspectionConfigurationName(ctx, conn, d, input.FirewallPolicy); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating NetworkFirewall Firewall Policy (%s): %s", name, err)
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -264,11 +317,17 @@ func resourceFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, met
 
 	response := output.FirewallPolicyResponse
 	d.Set(names.AttrARN, response.FirewallPolicyArn)
+	d.Set("consumed_stateful_capacity", response.ConsumedStatefulRuleCapacity)
+	d.Set("consumed_stateless_capacity", response.ConsumedStatelessRuleCapacity)
 	d.Set(names.AttrDescription, response.Description)
 	if err := d.Set(names.AttrEncryptionConfiguration, flattenEncryptionConfiguration(response.EncryptionConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
 	}
-	if err := d.Set("firewall_policy", flattenFirewallPolicy(output.FirewallPolicy)); err != nil {
+	firewallPolicy := flattenFirewallPolicy(output.FirewallPolicy)
+	if err := flattenFirewallPolicyTLSInspectionConfigurationName(ctx, conn, d, firewallPolicy); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("firewall_policy", firewallPolicy); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting firewall_policy: %s", err)
 	}
 	d.Set(names.AttrName, response.FirewallPolicyName)
@@ -296,7 +355,11 @@ func resourceFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 			input.Description = aws.String(v.(string))
 		}
 
-		_, err := conn.UpdateFirewallPolicy(ctx, input)
+		if err := resolveFirewallPolicyTLSInspectionConfigurationName(ctx, conn, d, input.FirewallPolicy); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
+		}
+
+		_, err := updateFirewallPolicy(ctx, conn, input, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
@@ -335,6 +398,133 @@ func resourceFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// updateFirewallPolicy calls UpdateFirewallPolicy, re-reading the policy and retrying with a fresh
+// update token if the supplied token has been invalidated by a concurrent update.
+// The retry resends the configured policy unchanged, so the concurrent update is overwritten (last writer wins).
+func updateFirewallPolicy(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.UpdateFirewallPolicyInput, timeout time.Duration) (*networkfirewall.UpdateFirewallPolicyOutput, error) {
+	outputRaw, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.UpdateFirewallPolicy(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if !errs.IsA[*awstypes.InvalidTokenException](err) {
+				return false, err
+			}
+
+			output, findErr := findFirewallPolicyByARN(ctx, conn, aws.ToString(input.FirewallPolicyArn))
+
+			if findErr != nil {
+				return false, findErr
+			}
+
+			log.Printf("[WARN] NetworkFirewall Firewall Policy (%s) was updated concurrently, overwriting it with the configured policy", aws.ToString(input.FirewallPolicyArn))
+			input.UpdateToken = output.UpdateToken
+
+			return true, err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*networkfirewall.UpdateFirewallPolicyOutput), nil
+}
+
+// customizeDiffStatefulRuleGroupRuleOrder rejects stateful rule group references whose rule order
+// differs from the policy's, which the API would otherwise only reject on apply.
+func customizeDiffStatefulRuleGroupRuleOrder(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("firewall_policy.0.stateful_engine_options", "firewall_policy.0.stateful_rule_group_reference") {
+		return nil
+	}
+
+	var ruleGroupARNs []string
+	for _, tfMapRaw := range d.Get("firewall_policy.0.stateful_rule_group_reference").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// The ARNs of rule groups created in the same configuration are not known until apply.
+		if v, ok := tfMap[names.AttrResourceARN].(string); ok && v != "" {
+			ruleGroupARNs = append(ruleGroupARNs, v)
+		}
+	}
+
+	if len(ruleGroupARNs) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
+
+	return validateStatefulRuleGroupRuleOrders(ctx, conn, d.Get("firewall_policy.0.stateful_engine_options.0.rule_order").(string), ruleGroupARNs)
+}
+
+// validateStatefulRuleGroupRuleOrders returns an error for each referenced stateful rule group whose
+// rule order does not match the policy's. Rule groups that no longer exist are ignored.
+func validateStatefulRuleGroupRuleOrders(ctx context.Context, conn *networkfirewall.Client, ruleOrder string, ruleGroupARNs []string) error {
+	policyRuleOrder := normalizeRuleOrder(ruleOrder)
+
+	var validationErrs []error
+	for _, ruleGroupARN := range ruleGroupARNs {
+		output, err := findRuleGroupByARN(ctx, conn, ruleGroupARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		// A rule group shared from another account may not be readable; leave the check to the API.
+		if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+			log.Printf("[WARN] Skipping rule order check of NetworkFirewall Rule Group (%s): %s", ruleGroupARN, err)
+			continue
+		}
+
+		if err != nil {
+			validationErrs = append(validationErrs, fmt.Errorf("reading NetworkFirewall Rule Group (%s): %w", ruleGroupARN, err))
+			continue
+		}
+
+		var ruleGroupRuleOrder awstypes.RuleOrder
+		if v := output.RuleGroup; v != nil && v.StatefulRuleOptions != nil {
+			ruleGroupRuleOrder = v.StatefulRuleOptions.RuleOrder
+		}
+
+		if v := normalizeRuleOrder(string(ruleGroupRuleOrder)); v != policyRuleOrder {
+			validationErrs = append(validationErrs, fmt.Errorf("stateful rule group (%s) uses rule order %s, which does not match the firewall policy's rule order %s", ruleGroupARN, v, policyRuleOrder))
+		}
+	}
+
+	return errors.Join(validationErrs...)
+}
+
+// normalizeRuleOrder returns the rule order in effect, which is DEFAULT_ACTION_ORDER when none is set.
+func normalizeRuleOrder(ruleOrder string) string {
+	if ruleOrder == "" {
+		return string(awstypes.RuleOrderDefaultActionOrder)
+	}
+
+	return ruleOrder
+}
+
+// resourceFirewallPolicyImport supports importing a firewall policy by ARN or by name.
+func resourceFirewallPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if arn.IsARN(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
+
+	output, err := findFirewallPolicyByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading NetworkFirewall Firewall Policy (%s): %w", d.Id(), err)
+	}
+
+	d.SetId(aws.ToString(output.FirewallPolicyResponse.FirewallPolicyArn))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findFirewallPolicy(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.DescribeFirewallPolicyInput) (*networkfirewall.DescribeFirewallPolicyOutput, error) {
 	output, err := conn.DescribeFirewallPolicy(ctx, input)
 
@@ -364,6 +554,14 @@ func findFirewallPolicyByARN(ctx context.Context, conn *networkfirewall.Client,
 	return findFirewallPolicy(ctx, conn, input)
 }
 
+func findFirewallPolicyByName(ctx context.Context, conn *networkfirewall.Client, name string) (*networkfirewall.DescribeFirewallPolicyOutput, error) {
+	input := &networkfirewall.DescribeFirewallPolicyInput{
+		FirewallPolicyName: aws.String(name),
+	}
+
+	return findFirewallPolicy(ctx, conn, input)
+}
+
 func statusFirewallPolicy(ctx context.Context, conn *networkfirewall.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findFirewallPolicyByARN(ctx, conn, arn)
@@ -397,6 +595,68 @@ func waitFirewallPolicyDeleted(ctx context.Context, conn *networkfirewall.Client
 	return nil, err
 }
 
+// resolveFirewallPolicyTLSInspectionConfigurationName sets the firewall policy's TLS inspection configuration ARN
+// from the configured tls_inspection_configuration_name, if any.
+func resolveFirewallPolicyTLSInspectionConfigurationName(ctx context.Context, conn *networkfirewall.Client, d *schema.ResourceData, apiObject *awstypes.FirewallPolicy) error {
+	name, ok := d.Get("firewall_policy.0.tls_inspection_configuration_name").(string)
+	if !ok || name == "" || apiObject == nil {
+		return nil
+	}
+
+	output, err := findTLSInspectionConfigurationByName(ctx, conn, name)
+
+	if err != nil {
+		return tlsInspectionConfigurationByNameError(name, err)
+	}
+
+	apiObject.TLSInspectionConfigurationArn = output.Arn
+
+	return nil
+}
+
+// flattenFirewallPolicyTLSInspectionConfigurationName keeps a TLS inspection configuration referenced by name in state
+// while the name still resolves to the ARN attached to the firewall policy. Otherwise the ARN is kept so that the drift is shown.
+func flattenFirewallPolicyTLSInspectionConfigurationName(ctx context.Context, conn *networkfirewall.Client, d *schema.ResourceData, tfList []interface{}) error {
+	name, ok := d.Get("firewall_policy.0.tls_inspection_configuration_name").(string)
+	if !ok || name == "" || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	arn, ok := tfMap["tls_inspection_configuration_arn"].(string)
+	if !ok || arn == "" {
+		return nil
+	}
+
+	output, err := findTLSInspectionConfigurationByName(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		return nil
+	case err != nil:
+		return tlsInspectionConfigurationByNameError(name, err)
+	case aws.ToString(output.Arn) != arn:
+		return nil
+	}
+
+	delete(tfMap, "tls_inspection_configuration_arn")
+	tfMap["tls_inspection_configuration_name"] = name
+
+	return nil
+}
+
+func tlsInspectionConfigurationByNameError(name string, err error) error {
+	if tfresource.NotFound(err) {
+		if errors.Is(err, tfresource.ErrTooManyResults) {
+			return fmt.Errorf("multiple NetworkFirewall TLS Inspection Configurations named (%s)", name)
+		}
+
+		return fmt.Errorf("NetworkFirewall TLS Inspection Configuration named (%s) not found", name)
+	}
+
+	return fmt.Errorf("reading NetworkFirewall TLS Inspection Configuration (%s): %w", name, err)
+}
+
 func expandPolicyVariables(tfMap map[string]interface{}) *awstypes.PolicyVariables {
 	if tfMap == nil {
 		return nil
@@ -34,6 +34,14 @@ func dataSourceFirewallPolicy() *schema.Resource {
 					Optional:     true,
 					ValidateFunc: verify.ValidARN,
 				},
+				"consumed_stateful_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"consumed_stateless_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				names.AttrDescription: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -132,6 +140,10 @@ func dataSourceFirewallPolicy() *schema.Resource {
 					AtLeastOneOf: []string{names.AttrARN, names.AttrName},
 					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]{1,128}$`), "Must have 1-128 valid characters: a-z, A-Z, 0-9 and -(hyphen)"),
 				},
+				"number_of_associations": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				names.AttrTags: tftags.TagsSchemaComputed(),
 				"update_token": {
 					Type:     schema.TypeString,
@@ -164,11 +176,14 @@ func dataSourceFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(resp.FirewallPolicyArn))
 	d.Set(names.AttrARN, resp.FirewallPolicyArn)
+	d.Set("consumed_stateful_capacity", resp.ConsumedStatefulRuleCapacity)
+	d.Set("consumed_stateless_capacity", resp.ConsumedStatelessRuleCapacity)
 	d.Set(names.AttrDescription, resp.Description)
 	if err := d.Set("firewall_policy", flattenFirewallPolicy(output.FirewallPolicy)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting firewall_policy: %s", err)
 	}
 	d.Set(names.AttrName, resp.FirewallPolicyName)
+	d.Set("number_of_associations", resp.NumberOfAssociations)
 	d.Set("update_token", output.UpdateToken)
 
 	setTagsOut(ctx, resp.Tags)
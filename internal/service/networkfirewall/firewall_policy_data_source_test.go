@@ -134,6 +134,30 @@ func TestAccNetworkFirewallFirewallPolicyDataSource_withOverriddenManagedRuleGro
 	})
 }
 
+func TestAccNetworkFirewallFirewallPolicyDataSource_associations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+	datasourceName := "data.aws_networkfirewall_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyDataSourceConfig_associations(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "consumed_stateful_capacity", resourceName, "consumed_stateful_capacity"),
+					resource.TestCheckResourceAttrPair(datasourceName, "consumed_stateless_capacity", resourceName, "consumed_stateless_capacity"),
+					resource.TestCheckResourceAttr(datasourceName, "number_of_associations", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func testAccFirewallPolicyDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_firewall_policy" "test" {
@@ -201,3 +225,11 @@ data "aws_networkfirewall_firewall_policy" "test" {
   arn = aws_networkfirewall_firewall_policy.test.arn
 }`, rName)
 }
+
+func testAccFirewallPolicyDataSourceConfig_associations(rName string) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_basic(rName), `
+data "aws_networkfirewall_firewall_policy" "test" {
+  arn = aws_networkfirewall_firewall.test.firewall_policy_arn
+}
+`)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_networkfirewall_firewall_policy_document", name="Firewall Policy Document")
+func dataSourceFirewallPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFirewallPolicyDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := firewallPolicySchema()
+
+			s[names.AttrJSON] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+
+			return s
+		},
+	}
+}
+
+func dataSourceFirewallPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfMap := make(map[string]interface{})
+	for k := range firewallPolicySchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	apiObject := expandFirewallPolicy([]interface{}{tfMap})
+
+	if err := validateFirewallPolicyDocument(apiObject); err != nil {
+		return sdkdiag.AppendErrorf(diags, "invalid Network Firewall Firewall Policy document: %s", err)
+	}
+
+	// Action order is not significant, so sort for a stable document.
+	slices.Sort(apiObject.StatefulDefaultActions)
+	slices.Sort(apiObject.StatelessDefaultActions)
+	slices.Sort(apiObject.StatelessFragmentDefaultActions)
+
+	jsonDoc, err := marshalDocument(apiObject)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "marshaling Network Firewall Firewall Policy document: %s", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+// validateFirewallPolicyDocument checks the action references and rule group priorities
+// of a firewall policy, returning all problems found.
+func validateFirewallPolicyDocument(apiObject *awstypes.FirewallPolicy) error {
+	var errs []error
+
+	customActionNames, err := validateCustomActionNames("stateless_custom_action", apiObject.StatelessCustomActions)
+	errs = append(errs, err)
+	errs = append(errs, validateStatelessActions("stateless_default_actions", apiObject.StatelessDefaultActions, customActionNames))
+	errs = append(errs, validateStatelessActions("stateless_fragment_default_actions", apiObject.StatelessFragmentDefaultActions, customActionNames))
+
+	statelessPriorities := make(map[int32]string)
+	for _, v := range apiObject.StatelessRuleGroupReferences {
+		priority, arn := aws.ToInt32(v.Priority), aws.ToString(v.ResourceArn)
+
+		if other, ok := statelessPriorities[priority]; ok {
+			errs = append(errs, fmt.Errorf("stateless_rule_group_reference: priority (%d) is used by both %s and %s", priority, other, arn))
+			continue
+		}
+
+		statelessPriorities[priority] = arn
+	}
+
+	var ruleOrder awstypes.RuleOrder
+	if apiObject.StatefulEngineOptions != nil {
+		ruleOrder = apiObject.StatefulEngineOptions.RuleOrder
+	}
+
+	if ruleOrder == awstypes.RuleOrderStrictOrder {
+		statefulPriorities := make(map[int32]string)
+		for _, v := range apiObject.StatefulRuleGroupReferences {
+			arn := aws.ToString(v.ResourceArn)
+
+			if v.Priority == nil {
+				errs = append(errs, fmt.Errorf("stateful_rule_group_reference: priority is required for %s when rule_order is %s", arn, ruleOrder))
+				continue
+			}
+
+			priority := aws.ToInt32(v.Priority)
+
+			if other, ok := statefulPriorities[priority]; ok {
+				errs = append(errs, fmt.Errorf("stateful_rule_group_reference: priority (%d) is used by both %s and %s", priority, other, arn))
+				continue
+			}
+
+			statefulPriorities[priority] = arn
+		}
+
+		for _, v := range apiObject.StatefulDefaultActions {
+			if !slices.Contains(statefulDefaultAction_Values(), v) {
+				errs = append(errs, fmt.Errorf("stateful_default_actions: unsupported action (%s), expected one of %s", v, strings.Join(statefulDefaultAction_Values(), ", ")))
+			}
+		}
+	} else {
+		for _, v := range apiObject.StatefulRuleGroupReferences {
+			if v.Priority != nil {
+				errs = append(errs, fmt.Errorf("stateful_rule_group_reference: priority is only supported for %s when rule_order is %s", aws.ToString(v.ResourceArn), awstypes.RuleOrderStrictOrder))
+			}
+		}
+
+		if len(apiObject.StatefulDefaultActions) > 0 {
+			errs = append(errs, fmt.Errorf("stateful_default_actions: only supported when rule_order is %s", awstypes.RuleOrderStrictOrder))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateCustomActionNames checks that custom action names are unique, returning the names.
+func validateCustomActionNames(attr string, apiObjects []awstypes.CustomAction) ([]string, error) {
+	var errs []error
+	actionNames := make([]string, 0, len(apiObjects))
+
+	for _, v := range apiObjects {
+		name := aws.ToString(v.ActionName)
+
+		if slices.Contains(actionNames, name) {
+			errs = append(errs, fmt.Errorf("%s: duplicate action_name (%s)", attr, name))
+			continue
+		}
+
+		actionNames = append(actionNames, name)
+	}
+
+	return actionNames, errors.Join(errs...)
+}
+
+// validateStatelessActions checks that a list of stateless actions contains exactly one
+// standard action, with any other actions referencing a defined custom action.
+func validateStatelessActions(attr string, actions, customActionNames []string) error {
+	var errs []error
+	var standardActions int
+
+	for _, v := range actions {
+		if slices.Contains(statelessAction_Values(), v) {
+			standardActions++
+			continue
+		}
+
+		if !slices.Contains(customActionNames, v) {
+			errs = append(errs, fmt.Errorf("%s: action (%s) is neither a standard action nor a defined stateless_custom_action", attr, v))
+		}
+	}
+
+	if standardActions != 1 {
+		errs = append(errs, fmt.Errorf("%s: exactly one of %s must be specified, got %d", attr, strings.Join(statelessAction_Values(), ", "), standardActions))
+	}
+
+	return errors.Join(errs...)
+}
+
+// marshalDocument marshals an API object to JSON, omitting unset fields.
+// The AWS SDK types carry no JSON tags, so unset enum values are removed explicitly.
+func marshalDocument(apiObject interface{}) ([]byte, error) {
+	jsonDoc, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(jsonDoc, &v); err != nil {
+		return nil, err
+	}
+
+	jsonDoc, err = json.Marshal(removeEmptyStrings(v))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfjson.RemoveEmptyFields(jsonDoc), nil
+}
+
+func removeEmptyStrings(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e == "" {
+				delete(v, k)
+				continue
+			}
+
+			v[k] = removeEmptyStrings(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = removeEmptyStrings(e)
+		}
+	}
+
+	return v
+}
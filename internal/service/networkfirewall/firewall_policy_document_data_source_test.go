@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallFirewallPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkfirewall_firewall_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccFirewallPolicyDocumentExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallFirewallPolicyDocumentDataSource_strictOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkfirewall_firewall_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyDocumentDataSourceConfig_strictOrder,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccFirewallPolicyDocumentExpectedJSON_strictOrder),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallFirewallPolicyDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallPolicyDocumentDataSourceConfig_undefinedCustomAction,
+				ExpectError: regexache.MustCompile(`neither a standard action nor a defined stateless_custom_action`),
+			},
+			{
+				Config:      testAccFirewallPolicyDocumentDataSourceConfig_duplicatePriority,
+				ExpectError: regexache.MustCompile(`priority \(10\) is used by both`),
+			},
+		},
+	})
+}
+
+func TestValidateFirewallPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	//lintignore:AWSAT003,AWSAT005
+	const (
+		arn1 = "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/one"
+		arn2 = "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/two"
+	)
+
+	customAction := awstypes.CustomAction{
+		ActionName:       aws.String("Counter"),
+		ActionDefinition: &awstypes.ActionDefinition{},
+	}
+
+	testCases := []struct {
+		TestName      string
+		Input         *awstypes.FirewallPolicy
+		ExpectedError string
+	}{
+		{
+			TestName: "valid",
+			Input: &awstypes.FirewallPolicy{
+				StatelessCustomActions:          []awstypes.CustomAction{customAction},
+				StatelessDefaultActions:         []string{"aws:pass", "Counter"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+				StatelessRuleGroupReferences: []awstypes.StatelessRuleGroupReference{
+					{Priority: aws.Int32(1), ResourceArn: aws.String(arn1)},
+					{Priority: aws.Int32(2), ResourceArn: aws.String(arn2)},
+				},
+			},
+		},
+		{
+			TestName: "no standard action",
+			Input: &awstypes.FirewallPolicy{
+				StatelessCustomActions:          []awstypes.CustomAction{customAction},
+				StatelessDefaultActions:         []string{"Counter"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "stateless_default_actions: exactly one of",
+		},
+		{
+			TestName: "multiple standard actions",
+			Input: &awstypes.FirewallPolicy{
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop", "aws:pass"},
+			},
+			ExpectedError: "stateless_fragment_default_actions: exactly one of",
+		},
+		{
+			TestName: "undefined custom action",
+			Input: &awstypes.FirewallPolicy{
+				StatelessDefaultActions:         []string{"aws:pass", "Counter"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "action (Counter) is neither a standard action",
+		},
+		{
+			TestName: "duplicate custom action",
+			Input: &awstypes.FirewallPolicy{
+				StatelessCustomActions:          []awstypes.CustomAction{customAction, customAction},
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "duplicate action_name (Counter)",
+		},
+		{
+			TestName: "duplicate stateless priority",
+			Input: &awstypes.FirewallPolicy{
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+				StatelessRuleGroupReferences: []awstypes.StatelessRuleGroupReference{
+					{Priority: aws.Int32(1), ResourceArn: aws.String(arn1)},
+					{Priority: aws.Int32(1), ResourceArn: aws.String(arn2)},
+				},
+			},
+			ExpectedError: "stateless_rule_group_reference: priority (1) is used by both",
+		},
+		{
+			TestName: "strict order missing priority",
+			Input: &awstypes.FirewallPolicy{
+				StatefulEngineOptions:           &awstypes.StatefulEngineOptions{RuleOrder: awstypes.RuleOrderStrictOrder},
+				StatefulRuleGroupReferences:     []awstypes.StatefulRuleGroupReference{{ResourceArn: aws.String(arn1)}},
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "priority is required",
+		},
+		{
+			TestName: "strict order duplicate priority",
+			Input: &awstypes.FirewallPolicy{
+				StatefulEngineOptions: &awstypes.StatefulEngineOptions{RuleOrder: awstypes.RuleOrderStrictOrder},
+				StatefulRuleGroupReferences: []awstypes.StatefulRuleGroupReference{
+					{Priority: aws.Int32(5), ResourceArn: aws.String(arn1)},
+					{Priority: aws.Int32(5), ResourceArn: aws.String(arn2)},
+				},
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "stateful_rule_group_reference: priority (5) is used by both",
+		},
+		{
+			TestName: "strict order invalid default action",
+			Input: &awstypes.FirewallPolicy{
+				StatefulDefaultActions:          []string{"aws:pass"},
+				StatefulEngineOptions:           &awstypes.StatefulEngineOptions{RuleOrder: awstypes.RuleOrderStrictOrder},
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "stateful_default_actions: unsupported action (aws:pass)",
+		},
+		{
+			TestName: "default order with priority",
+			Input: &awstypes.FirewallPolicy{
+				StatefulRuleGroupReferences:     []awstypes.StatefulRuleGroupReference{{Priority: aws.Int32(1), ResourceArn: aws.String(arn1)}},
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "priority is only supported",
+		},
+		{
+			TestName: "default order with stateful default actions",
+			Input: &awstypes.FirewallPolicy{
+				StatefulDefaultActions:          []string{"aws:drop_strict"},
+				StatelessDefaultActions:         []string{"aws:pass"},
+				StatelessFragmentDefaultActions: []string{"aws:drop"},
+			},
+			ExpectedError: "stateful_default_actions: only supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfnetworkfirewall.ValidateFirewallPolicyDocument(testCase.Input)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("error %q does not contain %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestMarshalDocument(t *testing.T) {
+	t.Parallel()
+
+	apiObject := &awstypes.FirewallPolicy{
+		StatefulEngineOptions: &awstypes.StatefulEngineOptions{
+			RuleOrder: awstypes.RuleOrderStrictOrder,
+		},
+		StatefulRuleGroupReferences: []awstypes.StatefulRuleGroupReference{
+			{
+				Override:    &awstypes.StatefulRuleGroupOverride{},
+				Priority:    aws.Int32(1),
+				ResourceArn: aws.String("rg"),
+			},
+		},
+		StatelessDefaultActions:         []string{"aws:pass"},
+		StatelessFragmentDefaultActions: []string{"aws:drop"},
+	}
+
+	got, err := tfnetworkfirewall.MarshalDocument(apiObject)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"StatefulEngineOptions":{"RuleOrder":"STRICT_ORDER"},"StatefulRuleGroupReferences":[{"Priority":1,"ResourceArn":"rg"}],"StatelessDefaultActions":["aws:pass"],"StatelessFragmentDefaultActions":["aws:drop"]}`
+
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// lintignore:AWSAT003,AWSAT005
+const testAccFirewallPolicyDocumentDataSourceConfig_basic = `
+data "aws_networkfirewall_firewall_policy_document" "test" {
+  stateless_default_actions          = ["aws:pass", "Counter"]
+  stateless_fragment_default_actions = ["aws:drop"]
+
+  stateless_custom_action {
+    action_definition {
+      publish_metric_action {
+        dimension {
+          value = "1"
+        }
+      }
+    }
+    action_name = "Counter"
+  }
+
+  stateless_rule_group_reference {
+    priority     = 10
+    resource_arn = "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/example"
+  }
+
+  stateful_rule_group_reference {
+    resource_arn = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/example"
+  }
+}
+`
+
+// lintignore:AWSAT003,AWSAT005
+const testAccFirewallPolicyDocumentExpectedJSON_basic = `{
+  "StatefulRuleGroupReferences": [
+    {
+      "ResourceArn": "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/example"
+    }
+  ],
+  "StatelessCustomActions": [
+    {
+      "ActionDefinition": {
+        "PublishMetricAction": {
+          "Dimensions": [
+            {
+              "Value": "1"
+            }
+          ]
+        }
+      },
+      "ActionName": "Counter"
+    }
+  ],
+  "StatelessDefaultActions": ["Counter", "aws:pass"],
+  "StatelessFragmentDefaultActions": ["aws:drop"],
+  "StatelessRuleGroupReferences": [
+    {
+      "Priority": 10,
+      "ResourceArn": "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/example"
+    }
+  ]
+}`
+
+// lintignore:AWSAT003,AWSAT005
+const testAccFirewallPolicyDocumentDataSourceConfig_strictOrder = `
+data "aws_networkfirewall_firewall_policy_document" "test" {
+  stateful_default_actions           = ["aws:drop_strict"]
+  stateless_default_actions          = ["aws:forward_to_sfe"]
+  stateless_fragment_default_actions = ["aws:forward_to_sfe"]
+
+  stateful_engine_options {
+    rule_order              = "STRICT_ORDER"
+    stream_exception_policy = "REJECT"
+  }
+
+  stateful_rule_group_reference {
+    priority     = 1
+    resource_arn = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/example"
+
+    override {
+      action = "DROP_TO_ALERT"
+    }
+  }
+}
+`
+
+// lintignore:AWSAT003,AWSAT005
+const testAccFirewallPolicyDocumentExpectedJSON_strictOrder = `{
+  "StatefulDefaultActions": ["aws:drop_strict"],
+  "StatefulEngineOptions": {
+    "RuleOrder": "STRICT_ORDER",
+    "StreamExceptionPolicy": "REJECT"
+  },
+  "StatefulRuleGroupReferences": [
+    {
+      "Override": {
+        "Action": "DROP_TO_ALERT"
+      },
+      "Priority": 1,
+      "ResourceArn": "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/example"
+    }
+  ],
+  "StatelessDefaultActions": ["aws:forward_to_sfe"],
+  "StatelessFragmentDefaultActions": ["aws:forward_to_sfe"]
+}`
+
+const testAccFirewallPolicyDocumentDataSourceConfig_undefinedCustomAction = `
+data "aws_networkfirewall_firewall_policy_document" "test" {
+  stateless_default_actions          = ["aws:pass", "Counter"]
+  stateless_fragment_default_actions = ["aws:drop"]
+}
+`
+
+// lintignore:AWSAT003,AWSAT005
+const testAccFirewallPolicyDocumentDataSourceConfig_duplicatePriority = `
+data "aws_networkfirewall_firewall_policy_document" "test" {
+  stateless_default_actions          = ["aws:pass"]
+  stateless_fragment_default_actions = ["aws:drop"]
+
+  stateless_rule_group_reference {
+    priority     = 10
+    resource_arn = "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/one"
+  }
+
+  stateless_rule_group_reference {
+    priority     = 10
+    resource_arn = "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/two"
+  }
+}
+`
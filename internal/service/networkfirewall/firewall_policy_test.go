@@ -6,11 +6,17 @@ package networkfirewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/aws/smithy-go"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,6 +27,379 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestUpdateFirewallPolicy_invalidTokenRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const (
+		policyARN = "arn:aws:network-firewall:us-west-2:123456789012:firewall-policy/test" //lintignore:AWSAT003,AWSAT005
+	)
+	var updateTokens []string
+	var updatePolicies []*awstypes.FirewallPolicy
+	var describeCount int
+
+	conn := newMockClient(func(_ context.Context, input any) (any, error) {
+		switch v := input.(type) {
+		case *networkfirewall.UpdateFirewallPolicyInput:
+			updateTokens = append(updateTokens, aws.ToString(v.UpdateToken))
+			updatePolicies = append(updatePolicies, v.FirewallPolicy)
+			if len(updateTokens) == 1 {
+				return nil, &awstypes.InvalidTokenException{Message: aws.String("update token is stale")}
+			}
+			return &networkfirewall.UpdateFirewallPolicyOutput{}, nil
+		case *networkfirewall.DescribeFirewallPolicyInput:
+			describeCount++
+			return &networkfirewall.DescribeFirewallPolicyOutput{
+				// The concurrent update changed the policy's default actions.
+				FirewallPolicy: &awstypes.FirewallPolicy{
+					StatelessDefaultActions:         []string{"aws:drop"},
+					StatelessFragmentDefaultActions: []string{"aws:drop"},
+				},
+				FirewallPolicyResponse: &awstypes.FirewallPolicyResponse{
+					FirewallPolicyArn: aws.String(policyARN),
+				},
+				UpdateToken: aws.String("fresh-token"),
+			}, nil
+		}
+		return nil, fmt.Errorf("unexpected operation input: %T", input)
+	})
+
+	policy := &awstypes.FirewallPolicy{
+		StatelessDefaultActions:         []string{"aws:pass"},
+		StatelessFragmentDefaultActions: []string{"aws:pass"},
+	}
+	input := &networkfirewall.UpdateFirewallPolicyInput{
+		FirewallPolicy:    policy,
+		FirewallPolicyArn: aws.String(policyARN),
+		UpdateToken:       aws.String("stale-token"),
+	}
+
+	if _, err := tfnetworkfirewall.UpdateFirewallPolicy(ctx, conn, input, 1*time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := describeCount, 1; got != want {
+		t.Errorf("DescribeFirewallPolicy calls = %d, want %d", got, want)
+	}
+	if got, want := updateTokens, []string{"stale-token", "fresh-token"}; !slices.Equal(got, want) {
+		t.Errorf("UpdateFirewallPolicy update tokens = %v, want %v", got, want)
+	}
+	// Last writer wins: the retry resends the configured policy, not the concurrently updated one.
+	for i, got := range updatePolicies {
+		if got != policy {
+			t.Errorf("UpdateFirewallPolicy call %d policy = %v, want the configured policy", i+1, got)
+		}
+	}
+}
+
+func TestUpdateTags_noUpdateToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const (
+		policyARN = "arn:aws:network-firewall:us-west-2:123456789012:firewall-policy/test" //lintignore:AWSAT003,AWSAT005
+	)
+	var operations []string
+
+	conn := newMockClient(func(_ context.Context, input any) (any, error) {
+		switch v := input.(type) {
+		case *networkfirewall.TagResourceInput:
+			operations = append(operations, "TagResource")
+			if got, want := aws.ToString(v.ResourceArn), policyARN; got != want {
+				t.Errorf("TagResource ResourceArn = %s, want %s", got, want)
+			}
+			return &networkfirewall.TagResourceOutput{}, nil
+		case *networkfirewall.UntagResourceInput:
+			operations = append(operations, "UntagResource")
+			if got, want := v.TagKeys, []string{"key2"}; !slices.Equal(got, want) {
+				t.Errorf("UntagResource TagKeys = %v, want %v", got, want)
+			}
+			return &networkfirewall.UntagResourceOutput{}, nil
+		}
+		// Any other operation, e.g. DescribeFirewallPolicy to fetch an update token, is unexpected.
+		return nil, fmt.Errorf("unexpected operation input: %T", input)
+	})
+
+	oldTags := map[string]string{"key1": "value1", "key2": "value2"}
+	newTags := map[string]string{"key1": "value1updated"}
+
+	if err := tfnetworkfirewall.UpdateTags(ctx, conn, policyARN, oldTags, newTags); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := operations, []string{"UntagResource", "TagResource"}; !slices.Equal(got, want) {
+		t.Errorf("operations = %v, want %v", got, want)
+	}
+}
+
+func TestValidateStatefulRuleGroupRuleOrders(t *testing.T) {
+	t.Parallel()
+
+	const (
+		defaultOrderRuleGroupARN = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/default" //lintignore:AWSAT003,AWSAT005
+		deniedRuleGroupARN       = "arn:aws:network-firewall:us-west-2:210987654321:stateful-rulegroup/denied"  //lintignore:AWSAT003,AWSAT005
+		missingRuleGroupARN      = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/missing" //lintignore:AWSAT003,AWSAT005
+		strictOrderRuleGroupARN  = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/strict"  //lintignore:AWSAT003,AWSAT005
+		failedRuleGroupARN       = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/failed"  //lintignore:AWSAT003,AWSAT005
+		unsetOrderRuleGroupARN   = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/unset"   //lintignore:AWSAT003,AWSAT005
+	)
+
+	ruleGroups := map[string]*awstypes.RuleGroup{
+		defaultOrderRuleGroupARN: {
+			StatefulRuleOptions: &awstypes.StatefulRuleOptions{RuleOrder: awstypes.RuleOrderDefaultActionOrder},
+		},
+		strictOrderRuleGroupARN: {
+			StatefulRuleOptions: &awstypes.StatefulRuleOptions{RuleOrder: awstypes.RuleOrderStrictOrder},
+		},
+		unsetOrderRuleGroupARN: {},
+	}
+
+	conn := newMockClient(func(_ context.Context, input any) (any, error) {
+		if v, ok := input.(*networkfirewall.DescribeRuleGroupInput); ok {
+			ruleGroupARN := aws.ToString(v.RuleGroupArn)
+			switch ruleGroupARN {
+			case deniedRuleGroupARN:
+				return nil, &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "not authorized"}
+			case failedRuleGroupARN:
+				return nil, &awstypes.InvalidRequestException{Message: aws.String("invalid request")}
+			}
+			ruleGroup, ok := ruleGroups[ruleGroupARN]
+			if !ok {
+				return nil, &awstypes.ResourceNotFoundException{Message: aws.String("rule group not found")}
+			}
+			return &networkfirewall.DescribeRuleGroupOutput{
+				RuleGroup: ruleGroup,
+				RuleGroupResponse: &awstypes.RuleGroupResponse{
+					RuleGroupArn: aws.String(ruleGroupARN),
+				},
+			}, nil
+		}
+		return nil, fmt.Errorf("unexpected operation input: %T", input)
+	})
+
+	testCases := map[string]struct {
+		ruleOrder     string
+		ruleGroupARNs []string
+		expectedError *regexp.Regexp
+	}{
+		"default policy, default rule groups": {
+			ruleOrder:     string(awstypes.RuleOrderDefaultActionOrder),
+			ruleGroupARNs: []string{defaultOrderRuleGroupARN, unsetOrderRuleGroupARN},
+		},
+		"unset policy, default rule groups": {
+			ruleGroupARNs: []string{defaultOrderRuleGroupARN, unsetOrderRuleGroupARN},
+		},
+		"strict policy, strict rule group": {
+			ruleOrder:     string(awstypes.RuleOrderStrictOrder),
+			ruleGroupARNs: []string{strictOrderRuleGroupARN},
+		},
+		"default policy, strict rule group": {
+			ruleOrder:     string(awstypes.RuleOrderDefaultActionOrder),
+			ruleGroupARNs: []string{defaultOrderRuleGroupARN, strictOrderRuleGroupARN},
+			expectedError: regexache.MustCompile(`stateful rule group \(.*/strict\) uses rule order STRICT_ORDER, which does not match the firewall policy's rule order DEFAULT_ACTION_ORDER`),
+		},
+		"strict policy, unset rule group": {
+			ruleOrder:     string(awstypes.RuleOrderStrictOrder),
+			ruleGroupARNs: []string{strictOrderRuleGroupARN, unsetOrderRuleGroupARN},
+			expectedError: regexache.MustCompile(`stateful rule group \(.*/unset\) uses rule order DEFAULT_ACTION_ORDER, which does not match the firewall policy's rule order STRICT_ORDER`),
+		},
+		"missing rule group": {
+			ruleOrder:     string(awstypes.RuleOrderStrictOrder),
+			ruleGroupARNs: []string{missingRuleGroupARN},
+		},
+		"access denied rule group": {
+			ruleOrder:     string(awstypes.RuleOrderStrictOrder),
+			ruleGroupARNs: []string{deniedRuleGroupARN, strictOrderRuleGroupARN},
+		},
+		"access denied rule group, mismatched rule group": {
+			ruleOrder:     string(awstypes.RuleOrderStrictOrder),
+			ruleGroupARNs: []string{deniedRuleGroupARN, defaultOrderRuleGroupARN},
+			expectedError: regexache.MustCompile(`stateful rule group \(.*/default\) uses rule order DEFAULT_ACTION_ORDER, which does not match the firewall policy's rule order STRICT_ORDER`),
+		},
+		"other error": {
+			ruleOrder:     string(awstypes.RuleOrderStrictOrder),
+			ruleGroupARNs: []string{failedRuleGroupARN},
+			expectedError: regexache.MustCompile(`reading NetworkFirewall Rule Group \(.*/failed\): .*InvalidRequestException`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			err := tfnetworkfirewall.ValidateStatefulRuleGroupRuleOrders(ctx, conn, testCase.ruleOrder, testCase.ruleGroupARNs)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error = %q, want match for %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestExpandEncryptionConfiguration(t *testing.T) {
+	t.Parallel()
+
+	keyARN := "arn:aws:kms:us-west-2:123456789012:key/test" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		tfList []interface{}
+		want   *awstypes.EncryptionConfiguration
+	}{
+		"empty": {
+			want: &awstypes.EncryptionConfiguration{Type: awstypes.EncryptionTypeAwsOwnedKmsKey},
+		},
+		"AWS owned key": {
+			tfList: []interface{}{map[string]interface{}{
+				names.AttrKeyID: "AWS_OWNED_KMS_KEY",
+				names.AttrType:  string(awstypes.EncryptionTypeAwsOwnedKmsKey),
+			}},
+			want: &awstypes.EncryptionConfiguration{Type: awstypes.EncryptionTypeAwsOwnedKmsKey},
+		},
+		"customer managed key": {
+			tfList: []interface{}{map[string]interface{}{
+				names.AttrKeyID: keyARN,
+				names.AttrType:  string(awstypes.EncryptionTypeCustomerKms),
+			}},
+			want: &awstypes.EncryptionConfiguration{KeyId: aws.String(keyARN), Type: awstypes.EncryptionTypeCustomerKms},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfnetworkfirewall.ExpandEncryptionConfiguration(testCase.tfList)
+
+			if got.Type != testCase.want.Type {
+				t.Errorf("Type = %s, want %s", got.Type, testCase.want.Type)
+			}
+			if (got.KeyId == nil) != (testCase.want.KeyId == nil) || aws.ToString(got.KeyId) != aws.ToString(testCase.want.KeyId) {
+				t.Errorf("KeyId = %v, want %v", got.KeyId, testCase.want.KeyId)
+			}
+		})
+	}
+}
+
+func TestFlattenFirewallPolicy_tlsInspectionConfigurationARNDrift(t *testing.T) {
+	t.Parallel()
+
+	const (
+		policyARN        = "arn:aws:network-firewall:us-west-2:123456789012:firewall-policy/test"               //lintignore:AWSAT003,AWSAT005
+		configurationARN = "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test"             //lintignore:AWSAT003,AWSAT005
+		replacementARN   = "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test-replacement" //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := map[string]struct {
+		config      map[string]interface{}
+		describeARN string
+		wantARN     string
+		wantName    string
+	}{
+		"ARN unchanged": {
+			config:      map[string]interface{}{"tls_inspection_configuration_arn": configurationARN},
+			describeARN: configurationARN,
+			wantARN:     configurationARN,
+		},
+		"ARN changed": {
+			config:      map[string]interface{}{"tls_inspection_configuration_arn": configurationARN},
+			describeARN: replacementARN,
+			wantARN:     replacementARN,
+		},
+		"ARN removed": {
+			config: map[string]interface{}{"tls_inspection_configuration_arn": configurationARN},
+		},
+		"name unchanged": {
+			config:      map[string]interface{}{"tls_inspection_configuration_name": "test"},
+			describeARN: configurationARN,
+			wantName:    "test",
+		},
+		"name changed": {
+			config:      map[string]interface{}{"tls_inspection_configuration_name": "test"},
+			describeARN: replacementARN,
+			wantARN:     replacementARN,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			conn := newMockClient(func(_ context.Context, input any) (any, error) {
+				switch input.(type) {
+				case *networkfirewall.DescribeFirewallPolicyInput:
+					output := &networkfirewall.DescribeFirewallPolicyOutput{
+						FirewallPolicy: &awstypes.FirewallPolicy{
+							StatelessDefaultActions:         []string{"aws:pass"},
+							StatelessFragmentDefaultActions: []string{"aws:drop"},
+						},
+						FirewallPolicyResponse: &awstypes.FirewallPolicyResponse{
+							FirewallPolicyArn: aws.String(policyARN),
+						},
+						UpdateToken: aws.String("token"),
+					}
+					if testCase.describeARN != "" {
+						output.FirewallPolicy.TLSInspectionConfigurationArn = aws.String(testCase.describeARN)
+					}
+					return output, nil
+				case *networkfirewall.ListTLSInspectionConfigurationsInput:
+					return &networkfirewall.ListTLSInspectionConfigurationsOutput{
+						TLSInspectionConfigurations: []awstypes.TLSInspectionConfigurationMetadata{
+							{Arn: aws.String(configurationARN), Name: aws.String("test")},
+						},
+					}, nil
+				}
+				return nil, fmt.Errorf("unexpected operation input: %T", input)
+			})
+
+			firewallPolicy := map[string]interface{}{
+				"stateless_default_actions":          []interface{}{"aws:pass"},
+				"stateless_fragment_default_actions": []interface{}{"aws:drop"},
+			}
+			for k, v := range testCase.config {
+				firewallPolicy[k] = v
+			}
+			d := sdkschema.TestResourceDataRaw(t, tfnetworkfirewall.ResourceFirewallPolicy().SchemaMap(), map[string]interface{}{
+				names.AttrName:    "test",
+				"firewall_policy": []interface{}{firewallPolicy},
+			})
+			d.SetId(policyARN)
+
+			output, err := tfnetworkfirewall.FindFirewallPolicyByARN(ctx, conn, d.Id())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			tfList := tfnetworkfirewall.FlattenFirewallPolicy(output.FirewallPolicy)
+			if err := tfnetworkfirewall.FlattenFirewallPolicyTLSInspectionConfigurationName(ctx, conn, d, tfList); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := d.Set("firewall_policy", tfList); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Get("firewall_policy.0.tls_inspection_configuration_arn").(string), testCase.wantARN; got != want {
+				t.Errorf("tls_inspection_configuration_arn = %q, want %q", got, want)
+			}
+			if got, want := d.Get("firewall_policy.0.tls_inspection_configuration_name").(string), testCase.wantName; got != want {
+				t.Errorf("tls_inspection_configuration_name = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccNetworkFirewallFirewallPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
@@ -38,6 +417,8 @@ func TestAccNetworkFirewallFirewallPolicy_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "network-firewall", fmt.Sprintf("firewall-policy/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateful_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateless_capacity", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.policy_variables.#", acctest.Ct0),
@@ -364,6 +745,41 @@ func TestAccNetworkFirewallFirewallPolicy_statefulRuleGroupReference(t *testing.
 	})
 }
 
+func TestAccNetworkFirewallFirewallPolicy_importByName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyConfig_statefulRuleGroupReference(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccFirewallPolicyImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"firewall_policy.0.stateful_rule_group_reference.0.priority"},
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: rName + "-not-found",
+				ExpectError:   regexache.MustCompile(`reading NetworkFirewall Firewall Policy`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewallPolicy_statefulRuleGroupReferenceManaged(t *testing.T) {
 	ctx := acctest.Context(t)
 	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
@@ -453,6 +869,8 @@ func TestAccNetworkFirewallFirewallPolicy_multipleStatefulRuleGroupReferences(t
 				Config: testAccFirewallPolicyConfig_multipleStatefulRuleGroupReferences(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateful_capacity", "200"),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateless_capacity", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateful_rule_group_reference.#", acctest.Ct2),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_policy.0.stateful_rule_group_reference.*.resource_arn", ruleGroupResourceName1, names.AttrARN),
@@ -463,6 +881,8 @@ func TestAccNetworkFirewallFirewallPolicy_multipleStatefulRuleGroupReferences(t
 				Config: testAccFirewallPolicyConfig_singleStatefulRuleGroupReference(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateful_capacity", "100"),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateless_capacity", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateful_rule_group_reference.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_policy.0.stateful_rule_group_reference.*.resource_arn", ruleGroupResourceName1, names.AttrARN),
@@ -583,6 +1003,35 @@ func TestAccNetworkFirewallFirewallPolicy_updateStatefulRuleGroupPriorityReferen
 	})
 }
 
+func TestAccNetworkFirewallFirewallPolicy_statelessRuleGroupReferenceByName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+	ruleGroupResourceName := "aws_networkfirewall_rule_group.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyConfig_statelessRuleGroupReferenceByName(rName, fmt.Sprintf("%s-0", rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_rule_group_reference.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_policy.0.stateless_rule_group_reference.*.resource_arn", ruleGroupResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config:      testAccFirewallPolicyConfig_statelessRuleGroupReferenceByName(rName, fmt.Sprintf("%s-missing", rName)),
+				ExpectError: regexache.MustCompile(`reading NetworkFirewall Rule Group Metadata`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewallPolicy_statelessRuleGroupReference(t *testing.T) {
 	ctx := acctest.Context(t)
 	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
@@ -693,6 +1142,8 @@ func TestAccNetworkFirewallFirewallPolicy_multipleStatelessRuleGroupReferences(t
 				Config: testAccFirewallPolicyConfig_multipleStatelessRuleGroupReferences(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateless_capacity", "200"),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateful_capacity", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_rule_group_reference.#", acctest.Ct2),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_policy.0.stateless_rule_group_reference.*.resource_arn", ruleGroupResourceName1, names.AttrARN),
@@ -709,6 +1160,8 @@ func TestAccNetworkFirewallFirewallPolicy_multipleStatelessRuleGroupReferences(t
 				Config: testAccFirewallPolicyConfig_singleStatelessRuleGroupReference(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateless_capacity", "100"),
+					resource.TestCheckResourceAttr(resourceName, "consumed_stateful_capacity", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.stateless_rule_group_reference.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "firewall_policy.0.stateless_rule_group_reference.*", map[string]string{
@@ -968,6 +1421,52 @@ func TestAccNetworkFirewallFirewallPolicy_tlsInspectionConfigurationARN(t *testi
 	})
 }
 
+func TestAccNetworkFirewallFirewallPolicy_tlsInspectionConfigurationName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+	tlsInspectionConfigurationResourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallPolicyConfig_tlsInspectionConfigurationNameNotFound(rName),
+				ExpectError: regexache.MustCompile(`TLS Inspection Configuration named \(` + rName + `-missing\) not found`),
+			},
+			{
+				Config: testAccFirewallPolicyConfig_tlsInspectionConfigurationName(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttrPair(resourceName, "firewall_policy.0.tls_inspection_configuration_name", tlsInspectionConfigurationResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.tls_inspection_configuration_arn", ""),
+					testAccCheckFirewallPolicyTLSInspectionConfigurationARN(&firewallPolicy, tlsInspectionConfigurationResourceName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"firewall_policy.0.tls_inspection_configuration_arn", "firewall_policy.0.tls_inspection_configuration_name"},
+			},
+			{
+				Config: testAccFirewallPolicyConfig_tlsInspectionConfiguration(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttrPair(resourceName, "firewall_policy.0.tls_inspection_configuration_arn", tlsInspectionConfigurationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "firewall_policy.0.tls_inspection_configuration_name", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewallPolicy_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
@@ -984,6 +1483,7 @@ func TestAccNetworkFirewallFirewallPolicy_tags(t *testing.T) {
 				Config: testAccFirewallPolicyConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					testAccCheckFirewallPolicyCreatedWithTag(ctx, &firewallPolicy, acctest.CtKey1, acctest.CtValue1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
 				),
@@ -1038,6 +1538,17 @@ func TestAccNetworkFirewallFirewallPolicy_disappears(t *testing.T) {
 	})
 }
 
+func testAccFirewallPolicyImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes[names.AttrName], nil
+	}
+}
+
 func testAccCheckFirewallPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -1085,6 +1596,19 @@ func testAccCheckFirewallPolicyExists(ctx context.Context, n string, v *networkf
 	}
 }
 
+// testAccCheckFirewallPolicyCreatedWithTag verifies that the tag is returned with the newly created firewall policy's description.
+func testAccCheckFirewallPolicyCreatedWithTag(ctx context.Context, v *networkfirewall.DescribeFirewallPolicyOutput, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tags := tfnetworkfirewall.KeyValueTags(ctx, v.FirewallPolicyResponse.Tags)
+
+		if got, ok := tags.Map()[key]; !ok || got != value {
+			return fmt.Errorf("NetworkFirewall Firewall Policy tag %q = %q, expected %q", key, got, value)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFirewallPolicyNotRecreated(i, j *networkfirewall.DescribeFirewallPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before, after := aws.ToString(i.FirewallPolicyResponse.FirewallPolicyId), aws.ToString(j.FirewallPolicyResponse.FirewallPolicyId); before != after {
@@ -1103,6 +1627,22 @@ func testAccCheckFirewallPolicyRecreated(i, j *networkfirewall.DescribeFirewallP
 	}
 }
 
+// testAccCheckFirewallPolicyTLSInspectionConfigurationARN checks that the firewall policy is attached to the TLS inspection configuration.
+func testAccCheckFirewallPolicyTLSInspectionConfigurationARN(v *networkfirewall.DescribeFirewallPolicyOutput, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if got, want := aws.ToString(v.FirewallPolicy.TLSInspectionConfigurationArn), rs.Primary.Attributes[names.AttrARN]; got != want {
+			return fmt.Errorf("TLS inspection configuration ARN = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccFirewallPolicyConfig_baseStatelessRuleGroup(rName string, count int) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1489,6 +2029,31 @@ resource "aws_networkfirewall_firewall_policy" "test" {
 `, rName, priority))
 }
 
+func testAccFirewallPolicyConfig_statelessRuleGroupReferenceByName(rName, ruleGroupName string) string {
+	return acctest.ConfigCompose(testAccFirewallPolicyConfig_baseStatelessRuleGroup(rName, 1), fmt.Sprintf(`
+data "aws_networkfirewall_rule_group_metadata" "test" {
+  name = %[2]q
+  type = "STATELESS"
+
+  depends_on = [aws_networkfirewall_rule_group.test]
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+
+    stateless_rule_group_reference {
+      priority     = 1
+      resource_arn = data.aws_networkfirewall_rule_group_metadata.test.arn
+    }
+  }
+}
+`, rName, ruleGroupName))
+}
+
 func testAccFirewallPolicyConfig_multipleStatelessRuleGroupReferences(rName string) string {
 	return acctest.ConfigCompose(testAccFirewallPolicyConfig_baseStatelessRuleGroup(rName, 2), fmt.Sprintf(`
 resource "aws_networkfirewall_firewall_policy" "test" {
@@ -1659,6 +2224,48 @@ resource "aws_networkfirewall_firewall_policy" "test" {
 `, rName, arn)
 }
 
+func testAccFirewallPolicyConfig_tlsInspectionConfiguration(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_arn   = aws_networkfirewall_tls_inspection_configuration.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccFirewallPolicyConfig_tlsInspectionConfigurationName(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_name  = aws_networkfirewall_tls_inspection_configuration.test.name
+  }
+}
+`, rName))
+}
+
+func testAccFirewallPolicyConfig_tlsInspectionConfigurationNameNotFound(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_name  = "%[1]s-missing"
+  }
+}
+`, rName)
+}
+
 func testAccFirewallPolicyConfig_encryptionConfiguration(rName, statelessDefaultActions string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {}
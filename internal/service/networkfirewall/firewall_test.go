@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -59,6 +60,7 @@ func TestAccNetworkFirewallFirewall_basic(t *testing.T) {
 						names.AttrIPAddressType: string(awstypes.IPAddressTypeIpv4),
 					}),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration_arn", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
 				),
 			},
@@ -187,6 +189,11 @@ func TestAccNetworkFirewallFirewall_deleteProtection(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "delete_protection", acctest.CtTrue),
 				),
 			},
+			{
+				Config:      testAccFirewallConfig_deleteProtection(rName, true),
+				Destroy:     true,
+				ExpectError: regexache.MustCompile(`delete_protection is enabled, set it to false and apply before destroying`),
+			},
 			{
 				Config: testAccFirewallConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -360,6 +367,27 @@ func TestAccNetworkFirewallFirewall_SubnetMappings_updateMultipleSubnets(t *test
 	})
 }
 
+func TestAccNetworkFirewallFirewall_SubnetMappings_sameAvailabilityZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig_sameAvailabilityZoneSubnets(rName),
+			},
+			{
+				Config:      testAccFirewallConfig_sameAvailabilityZone(rName),
+				ExpectError: regexache.MustCompile(`NetworkFirewall supports only one subnet per Availability Zone`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewall_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -427,6 +455,35 @@ func TestAccNetworkFirewallFirewall_disappears(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallFirewall_endpointsDeleted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var endpointIDs []string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallEndpointIDs(ctx, resourceName, &endpointIDs),
+				),
+			},
+			{
+				// Destroying only the firewall must leave no VPC endpoints behind.
+				Config: testAccFirewallConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallEndpointsDeleted(ctx, &endpointIDs),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFirewallDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -468,6 +525,55 @@ func testAccCheckFirewallExists(ctx context.Context, n string) resource.TestChec
 	}
 }
 
+func testAccCheckFirewallEndpointIDs(ctx context.Context, n string, v *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallClient(ctx)
+
+		output, err := tfnetworkfirewall.FindFirewallByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		endpointIDs := tfnetworkfirewall.FirewallEndpointIDs(output.FirewallStatus)
+
+		if len(endpointIDs) == 0 {
+			return fmt.Errorf("NetworkFirewall Firewall %s has no VPC endpoints", rs.Primary.ID)
+		}
+
+		*v = endpointIDs
+
+		return nil
+	}
+}
+
+func testAccCheckFirewallEndpointsDeleted(ctx context.Context, v *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, endpointID := range *v {
+			_, err := tfec2.FindVPCEndpointByID(ctx, conn, endpointID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("NetworkFirewall Firewall VPC endpoint %s still exists", endpointID)
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallClient(ctx)
 
@@ -687,3 +793,35 @@ resource "aws_networkfirewall_firewall" "test" {
 }
 `, rName))
 }
+
+func testAccFirewallConfig_sameAvailabilityZoneSubnets(rName string) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_base(rName), fmt.Sprintf(`
+resource "aws_subnet" "example" {
+  availability_zone = aws_subnet.test[0].availability_zone
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 1)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccFirewallConfig_sameAvailabilityZone(rName string) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_sameAvailabilityZoneSubnets(rName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test[0].id
+  }
+
+  subnet_mapping {
+    subnet_id = aws_subnet.example.id
+  }
+}
+`, rName))
+}
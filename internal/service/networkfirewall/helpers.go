@@ -43,12 +43,13 @@ func expandEncryptionConfiguration(tfList []interface{}) *awstypes.EncryptionCon
 	if len(tfList) == 1 && tfList[0] != nil {
 		tfMap := tfList[0].(map[string]interface{})
 
-		if v, ok := tfMap[names.AttrKeyID].(string); ok {
-			apiObject.KeyId = aws.String(v)
-		}
 		if v, ok := tfMap[names.AttrType].(string); ok {
 			apiObject.Type = awstypes.EncryptionType(v)
 		}
+		// KeyId is only valid for customer managed keys.
+		if v, ok := tfMap[names.AttrKeyID].(string); ok && v != "" && apiObject.Type == awstypes.EncryptionTypeCustomerKms {
+			apiObject.KeyId = aws.String(v)
+		}
 	}
 
 	return apiObject
@@ -95,8 +96,9 @@ func customActionSchema() *schema.Schema {
 											Elem: &schema.Resource{
 												Schema: map[string]*schema.Schema{
 													names.AttrValue: {
-														Type:     schema.TypeString,
-														Required: true,
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validCustomActionDimensionValue,
 													},
 												},
 											},
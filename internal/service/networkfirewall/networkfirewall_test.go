@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// newMockClient returns a client whose operations are answered by handler without sending any requests.
+func newMockClient(handler acctest.MockHandler) *networkfirewall.Client {
+	return networkfirewall.New(networkfirewall.Options{
+		Region:     acctest.MockRegion,
+		APIOptions: acctest.MockAPIOptions(handler),
+	})
+}
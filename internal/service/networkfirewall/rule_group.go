@@ -5,7 +5,10 @@ package networkfirewall
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -62,6 +65,10 @@ func resourceRuleGroup() *schema.Resource {
 					Required: true,
 					ForceNew: true,
 				},
+				"number_of_associations": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				"rule_group": {
 					Type:     schema.TypeList,
 					MaxItems: 1,
@@ -143,8 +150,9 @@ func resourceRuleGroup() *schema.Resource {
 											},
 										},
 										"rules_string": {
-											Type:     schema.TypeString,
-											Optional: true,
+											Type:             schema.TypeString,
+											Optional:         true,
+											DiffSuppressFunc: suppressEquivalentSuricataRules,
 										},
 										"stateful_rule": {
 											Type:     schema.TypeList,
@@ -163,12 +171,14 @@ func resourceRuleGroup() *schema.Resource {
 														Elem: &schema.Resource{
 															Schema: map[string]*schema.Schema{
 																names.AttrDestination: {
-																	Type:     schema.TypeString,
-																	Required: true,
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validStatefulRuleHeaderAddress,
 																},
 																"destination_port": {
-																	Type:     schema.TypeString,
-																	Required: true,
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validStatefulRuleHeaderPort,
 																},
 																"direction": {
 																	Type:             schema.TypeString,
@@ -181,12 +191,14 @@ func resourceRuleGroup() *schema.Resource {
 																	ValidateDiagFunc: enum.Validate[awstypes.StatefulRuleProtocol](),
 																},
 																names.AttrSource: {
-																	Type:     schema.TypeString,
-																	Required: true,
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validStatefulRuleHeaderAddress,
 																},
 																"source_port": {
-																	Type:     schema.TypeString,
-																	Required: true,
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validStatefulRuleHeaderPort,
 																},
 															},
 														},
@@ -216,131 +228,7 @@ func resourceRuleGroup() *schema.Resource {
 											MaxItems: 1,
 											Optional: true,
 											Elem: &schema.Resource{
-												Schema: map[string]*schema.Schema{
-													"custom_action": customActionSchema(),
-													"stateless_rule": {
-														Type:     schema.TypeSet,
-														Required: true,
-														Elem: &schema.Resource{
-															Schema: map[string]*schema.Schema{
-																names.AttrPriority: {
-																	Type:     schema.TypeInt,
-																	Required: true,
-																},
-																"rule_definition": {
-																	Type:     schema.TypeList,
-																	MaxItems: 1,
-																	Required: true,
-																	Elem: &schema.Resource{
-																		Schema: map[string]*schema.Schema{
-																			names.AttrActions: {
-																				Type:     schema.TypeSet,
-																				Required: true,
-																				Elem:     &schema.Schema{Type: schema.TypeString},
-																			},
-																			"match_attributes": {
-																				Type:     schema.TypeList,
-																				MaxItems: 1,
-																				Required: true,
-																				Elem: &schema.Resource{
-																					Schema: map[string]*schema.Schema{
-																						names.AttrDestination: {
-																							Type:     schema.TypeSet,
-																							Optional: true,
-																							Elem: &schema.Resource{
-																								Schema: map[string]*schema.Schema{
-																									"address_definition": {
-																										Type:         schema.TypeString,
-																										Required:     true,
-																										ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
-																									},
-																								},
-																							},
-																						},
-																						"destination_port": {
-																							Type:     schema.TypeSet,
-																							Optional: true,
-																							Elem: &schema.Resource{
-																								Schema: map[string]*schema.Schema{
-																									"from_port": {
-																										Type:     schema.TypeInt,
-																										Required: true,
-																									},
-																									"to_port": {
-																										Type:     schema.TypeInt,
-																										Optional: true,
-																									},
-																								},
-																							},
-																						},
-																						"protocols": {
-																							Type:     schema.TypeSet,
-																							Optional: true,
-																							Elem:     &schema.Schema{Type: schema.TypeInt},
-																						},
-																						names.AttrSource: {
-																							Type:     schema.TypeSet,
-																							Optional: true,
-																							Elem: &schema.Resource{
-																								Schema: map[string]*schema.Schema{
-																									"address_definition": {
-																										Type:         schema.TypeString,
-																										Required:     true,
-																										ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
-																									},
-																								},
-																							},
-																						},
-																						"source_port": {
-																							Type:     schema.TypeSet,
-																							Optional: true,
-																							Elem: &schema.Resource{
-																								Schema: map[string]*schema.Schema{
-																									"from_port": {
-																										Type:     schema.TypeInt,
-																										Required: true,
-																									},
-																									"to_port": {
-																										Type:     schema.TypeInt,
-																										Optional: true,
-																									},
-																								},
-																							},
-																						},
-																						"tcp_flag": {
-																							Type:     schema.TypeSet,
-																							Optional: true,
-																							Elem: &schema.Resource{
-																								Schema: map[string]*schema.Schema{
-																									"flags": {
-																										Type:     schema.TypeSet,
-																										Required: true,
-																										Elem: &schema.Schema{
-																											Type:             schema.TypeString,
-																											ValidateDiagFunc: enum.Validate[awstypes.TCPFlag](),
-																										},
-																									},
-																									"masks": {
-																										Type:     schema.TypeSet,
-																										Optional: true,
-																										Elem: &schema.Schema{
-																											Type:             schema.TypeString,
-																											ValidateDiagFunc: enum.Validate[awstypes.TCPFlag](),
-																										},
-																									},
-																								},
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
+												Schema: statelessRulesAndCustomActionsSchema(),
 											},
 										},
 									},
@@ -375,7 +263,10 @@ func resourceRuleGroup() *schema.Resource {
 																"definition": {
 																	Type:     schema.TypeSet,
 																	Required: true,
-																	Elem:     &schema.Schema{Type: schema.TypeString},
+																	Elem: &schema.Schema{
+																		Type:         schema.TypeString,
+																		ValidateFunc: validCIDRBlock,
+																	},
 																},
 															},
 														},
@@ -406,7 +297,10 @@ func resourceRuleGroup() *schema.Resource {
 																"definition": {
 																	Type:     schema.TypeSet,
 																	Required: true,
-																	Elem:     &schema.Schema{Type: schema.TypeString},
+																	Elem: &schema.Schema{
+																		Type:         schema.TypeString,
+																		ValidateFunc: validPortRange,
+																	},
 																},
 															},
 														},
@@ -435,8 +329,9 @@ func resourceRuleGroup() *schema.Resource {
 					},
 				},
 				"rules": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: suppressEquivalentSuricataRules,
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -458,11 +353,149 @@ func resourceRuleGroup() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("rule_group.0.stateful_rule_options.0.rule_order", d)
 			},
+			customizeDiffRuleGroupCapacity,
+			customizeDiffRuleGroupTCPFlags,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+// statelessRulesAndCustomActionsSchema returns the schema of the stateless_rules_and_custom_actions block.
+func statelessRulesAndCustomActionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"custom_action": customActionSchema(),
+		"stateless_rule": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrPriority: {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"rule_definition": {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Required: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								names.AttrActions: {
+									Type:     schema.TypeSet,
+									Required: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+								"match_attributes": {
+									Type:     schema.TypeList,
+									MaxItems: 1,
+									Required: true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											names.AttrDestination: {
+												Type:     schema.TypeSet,
+												Optional: true,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"address_definition": {
+															Type:         schema.TypeString,
+															Required:     true,
+															ValidateFunc: validCIDRBlock,
+														},
+													},
+												},
+											},
+											"destination_port": {
+												Type:     schema.TypeSet,
+												Optional: true,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"from_port": {
+															Type:         schema.TypeInt,
+															Required:     true,
+															ValidateFunc: validPortNumber,
+														},
+														"to_port": {
+															Type:         schema.TypeInt,
+															Optional:     true,
+															ValidateFunc: validPortNumber,
+														},
+													},
+												},
+											},
+											"protocols": {
+												Type:     schema.TypeSet,
+												Optional: true,
+												Elem: &schema.Schema{
+													Type:         schema.TypeInt,
+													ValidateFunc: validProtocolNumber,
+												},
+											},
+											names.AttrSource: {
+												Type:     schema.TypeSet,
+												Optional: true,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"address_definition": {
+															Type:         schema.TypeString,
+															Required:     true,
+															ValidateFunc: validCIDRBlock,
+														},
+													},
+												},
+											},
+											"source_port": {
+												Type:     schema.TypeSet,
+												Optional: true,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"from_port": {
+															Type:         schema.TypeInt,
+															Required:     true,
+															ValidateFunc: validPortNumber,
+														},
+														"to_port": {
+															Type:         schema.TypeInt,
+															Optional:     true,
+															ValidateFunc: validPortNumber,
+														},
+													},
+												},
+											},
+											"tcp_flag": {
+												Type:     schema.TypeSet,
+												Optional: true,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"flags": {
+															Type:     schema.TypeSet,
+															Required: true,
+															Elem: &schema.Schema{
+																Type:             schema.TypeString,
+																ValidateDiagFunc: enum.Validate[awstypes.TCPFlag](),
+															},
+														},
+														"masks": {
+															Type:     schema.TypeSet,
+															Optional: true,
+															Elem: &schema.Schema{
+																Type:             schema.TypeString,
+																ValidateDiagFunc: enum.Validate[awstypes.TCPFlag](),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
@@ -530,6 +563,7 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
 	}
 	d.Set(names.AttrName, response.RuleGroupName)
+	d.Set("number_of_associations", response.NumberOfAssociations)
 	if err := d.Set("rule_group", flattenRuleGroup(output.RuleGroup)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule_group: %s", err)
 	}
@@ -594,6 +628,11 @@ func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).NetworkFirewallClient(ctx)
 
+	// Deletion of a rule group still referenced by firewall policies is retried until the references are removed.
+	if v := d.Get("number_of_associations").(int); v > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "NetworkFirewall Rule Group (%s) is referenced by %d firewall policies; deletion waits until it is no longer in use", d.Id(), v)
+	}
+
 	log.Printf("[DEBUG] Deleting NetworkFirewall Rule Group: %s", d.Id())
 	const (
 		timeout = 10 * time.Minute
@@ -677,6 +716,70 @@ func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.Client, arn
 	return nil, err
 }
 
+// customizeDiffRuleGroupCapacity validates the capacity against the limits for the rule group type
+// once both are known.
+func customizeDiffRuleGroupCapacity(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("capacity") || !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	return validateRuleGroupCapacity(awstypes.RuleGroupType(d.Get(names.AttrType).(string)), d.Get("capacity").(int))
+}
+
+// customizeDiffRuleGroupTCPFlags validates the TCP flag match attributes of stateless rules
+// once the rule group configuration is fully known.
+func customizeDiffRuleGroupTCPFlags(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.GetRawConfig(); v.IsNull() || !v.GetAttr("rule_group").IsWhollyKnown() {
+		return nil
+	}
+
+	tfList, ok := d.Get("rule_group").([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := expandRuleGroup(tfList[0].(map[string]interface{}))
+	if apiObject.RulesSource == nil || apiObject.RulesSource.StatelessRulesAndCustomActions == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, rule := range apiObject.RulesSource.StatelessRulesAndCustomActions.StatelessRules {
+		if rule.RuleDefinition == nil || rule.RuleDefinition.MatchAttributes == nil {
+			continue
+		}
+
+		for _, v := range rule.RuleDefinition.MatchAttributes.TCPFlags {
+			if err := validateTCPFlagField(v); err != nil {
+				errs = append(errs, fmt.Errorf("stateless_rule (priority %d): tcp_flag: %w", aws.ToInt32(rule.Priority), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// suppressEquivalentSuricataRules suppresses diffs between Suricata rule strings that differ only in
+// line endings, trailing whitespace or blank lines.
+func suppressEquivalentSuricataRules(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeSuricataRules(old) == normalizeSuricataRules(new)
+}
+
+func normalizeSuricataRules(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		// Leading whitespace and whitespace within a rule may be significant, e.g. in content matches.
+		if line = strings.TrimRight(line, " \t"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func expandStatefulRuleHeader(tfList []interface{}) *awstypes.Header {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	statelessRulePriorityMax = 65535
+)
+
+// @SDKDataSource("aws_networkfirewall_rule_group_document", name="Rule Group Document")
+func dataSourceRuleGroupDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleGroupDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := statelessRulesAndCustomActionsSchema()
+
+			s[names.AttrJSON] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+
+			return s
+		},
+	}
+}
+
+func dataSourceRuleGroupDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfMap := make(map[string]interface{})
+	for k := range statelessRulesAndCustomActionsSchema() {
+		tfMap[k] = d.Get(k)
+	}
+
+	apiObject := expandStatelessRulesAndCustomActions([]interface{}{tfMap})
+
+	// An omitted to_port matches the single port from_port.
+	for _, rule := range apiObject.StatelessRules {
+		if rule.RuleDefinition == nil || rule.RuleDefinition.MatchAttributes == nil {
+			continue
+		}
+
+		for _, portRanges := range [][]awstypes.PortRange{rule.RuleDefinition.MatchAttributes.DestinationPorts, rule.RuleDefinition.MatchAttributes.SourcePorts} {
+			for i, v := range portRanges {
+				if v.ToPort == 0 {
+					portRanges[i].ToPort = v.FromPort
+				}
+			}
+		}
+	}
+
+	if err := validateRuleGroupDocument(apiObject); err != nil {
+		return sdkdiag.AppendErrorf(diags, "invalid Network Firewall Rule Group document: %s", err)
+	}
+
+	// Rule and action order is not significant, so sort for a stable document.
+	slices.SortFunc(apiObject.StatelessRules, func(a, b awstypes.StatelessRule) int {
+		return cmp.Compare(aws.ToInt32(a.Priority), aws.ToInt32(b.Priority))
+	})
+	for _, rule := range apiObject.StatelessRules {
+		if rule.RuleDefinition == nil {
+			continue
+		}
+
+		slices.Sort(rule.RuleDefinition.Actions)
+
+		if v := rule.RuleDefinition.MatchAttributes; v != nil {
+			slices.Sort(v.Protocols)
+
+			for _, v := range v.TCPFlags {
+				slices.Sort(v.Flags)
+				slices.Sort(v.Masks)
+			}
+		}
+	}
+
+	jsonDoc, err := marshalDocument(apiObject)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "marshaling Network Firewall Rule Group document: %s", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+// validateRuleGroupDocument checks the priorities, match attributes and action references
+// of a stateless rule group's rules, returning all problems found.
+func validateRuleGroupDocument(apiObject *awstypes.StatelessRulesAndCustomActions) error {
+	var errs []error
+
+	customActionNames, err := validateCustomActionNames("custom_action", apiObject.CustomActions)
+	errs = append(errs, err)
+
+	priorities := make(map[int32]struct{})
+	for _, rule := range apiObject.StatelessRules {
+		priority := aws.ToInt32(rule.Priority)
+		attr := fmt.Sprintf("stateless_rule (priority %d)", priority)
+
+		if priority < 1 || priority > statelessRulePriorityMax {
+			errs = append(errs, fmt.Errorf("%s: priority must be between 1 and %d", attr, statelessRulePriorityMax))
+		}
+
+		if _, ok := priorities[priority]; ok {
+			errs = append(errs, fmt.Errorf("%s: duplicate priority", attr))
+		}
+		priorities[priority] = struct{}{}
+
+		if rule.RuleDefinition == nil {
+			continue
+		}
+
+		errs = append(errs, validateStatelessActions(attr+": actions", rule.RuleDefinition.Actions, customActionNames))
+
+		if v := rule.RuleDefinition.MatchAttributes; v != nil {
+			errs = append(errs, validateMatchAttributes(attr+": match_attributes", v))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateMatchAttributes(attr string, apiObject *awstypes.MatchAttributes) error {
+	var errs []error
+
+	for _, v := range apiObject.Protocols {
+		if v < 0 || v > protocolNumberMax {
+			errs = append(errs, fmt.Errorf("%s: protocol (%d) must be between 0 and %d", attr, v, protocolNumberMax))
+		}
+	}
+
+	errs = append(errs, validatePortRanges(attr+": destination_port", apiObject.DestinationPorts))
+	errs = append(errs, validatePortRanges(attr+": source_port", apiObject.SourcePorts))
+
+	for _, v := range apiObject.TCPFlags {
+		if err := validateTCPFlagField(v); err != nil {
+			errs = append(errs, fmt.Errorf("%s: tcp_flag: %w", attr, err))
+		}
+	}
+
+	if len(apiObject.TCPFlags) > 0 && len(apiObject.Protocols) > 0 && !slices.Contains(apiObject.Protocols, protocolNumberTCP) {
+		errs = append(errs, fmt.Errorf("%s: tcp_flag requires protocols to include TCP (%d)", attr, protocolNumberTCP))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validatePortRanges(attr string, apiObjects []awstypes.PortRange) error {
+	var errs []error
+
+	for _, v := range apiObjects {
+		if v.FromPort < 0 || v.FromPort > portNumberMax || v.ToPort < 0 || v.ToPort > portNumberMax {
+			errs = append(errs, fmt.Errorf("%s: ports (%d-%d) must be between 0 and %d", attr, v.FromPort, v.ToPort, portNumberMax))
+			continue
+		}
+
+		if v.FromPort > v.ToPort {
+			errs = append(errs, fmt.Errorf("%s: from_port (%d) must not be greater than to_port (%d)", attr, v.FromPort, v.ToPort))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallRuleGroupDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkfirewall_rule_group_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccRuleGroupDocumentExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroupDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupDocumentDataSourceConfig_undefinedCustomAction,
+				ExpectError: regexache.MustCompile(`action \(Undefined\) is neither a standard action`),
+			},
+			{
+				Config:      testAccRuleGroupDocumentDataSourceConfig_invalidPortRange,
+				ExpectError: regexache.MustCompile(`from_port \(443\) must not be greater than to_port \(80\)`),
+			},
+		},
+	})
+}
+
+func TestValidateRuleGroupDocument(t *testing.T) {
+	t.Parallel()
+
+	customAction := awstypes.CustomAction{
+		ActionName:       aws.String("Counter"),
+		ActionDefinition: &awstypes.ActionDefinition{},
+	}
+	rule := func(priority int32, matchAttributes *awstypes.MatchAttributes, actions ...string) awstypes.StatelessRule {
+		return awstypes.StatelessRule{
+			Priority: aws.Int32(priority),
+			RuleDefinition: &awstypes.RuleDefinition{
+				Actions:         actions,
+				MatchAttributes: matchAttributes,
+			},
+		}
+	}
+
+	testCases := []struct {
+		TestName      string
+		Input         *awstypes.StatelessRulesAndCustomActions
+		ExpectedError string
+	}{
+		{
+			TestName: "valid",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				CustomActions: []awstypes.CustomAction{customAction},
+				StatelessRules: []awstypes.StatelessRule{
+					rule(1, &awstypes.MatchAttributes{
+						DestinationPorts: []awstypes.PortRange{{FromPort: 443, ToPort: 443}},
+						Protocols:        []int32{6},
+						TCPFlags:         []awstypes.TCPFlagField{{Flags: []awstypes.TCPFlag{awstypes.TCPFlagSyn}}},
+					}, "aws:pass", "Counter"),
+					rule(2, &awstypes.MatchAttributes{Protocols: []int32{17}}, "aws:drop"),
+				},
+			},
+		},
+		{
+			TestName: "duplicate priority",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				StatelessRules: []awstypes.StatelessRule{
+					rule(1, &awstypes.MatchAttributes{}, "aws:pass"),
+					rule(1, &awstypes.MatchAttributes{}, "aws:drop"),
+				},
+			},
+			ExpectedError: "stateless_rule (priority 1): duplicate priority",
+		},
+		{
+			TestName: "priority out of range",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				StatelessRules: []awstypes.StatelessRule{rule(65536, &awstypes.MatchAttributes{}, "aws:pass")},
+			},
+			ExpectedError: "priority must be between 1 and 65535",
+		},
+		{
+			TestName: "no standard action",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				CustomActions:  []awstypes.CustomAction{customAction},
+				StatelessRules: []awstypes.StatelessRule{rule(1, &awstypes.MatchAttributes{}, "Counter")},
+			},
+			ExpectedError: "actions: exactly one of",
+		},
+		{
+			TestName: "undefined custom action",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				StatelessRules: []awstypes.StatelessRule{rule(1, &awstypes.MatchAttributes{}, "aws:pass", "Counter")},
+			},
+			ExpectedError: "action (Counter) is neither a standard action",
+		},
+		{
+			TestName: "duplicate custom action",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				CustomActions:  []awstypes.CustomAction{customAction, customAction},
+				StatelessRules: []awstypes.StatelessRule{rule(1, &awstypes.MatchAttributes{}, "aws:pass")},
+			},
+			ExpectedError: "custom_action: duplicate action_name (Counter)",
+		},
+		{
+			TestName: "protocol out of range",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				StatelessRules: []awstypes.StatelessRule{rule(1, &awstypes.MatchAttributes{Protocols: []int32{256}}, "aws:pass")},
+			},
+			ExpectedError: "protocol (256) must be between 0 and 255",
+		},
+		{
+			TestName: "port out of range",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				StatelessRules: []awstypes.StatelessRule{rule(1, &awstypes.MatchAttributes{
+					SourcePorts: []awstypes.PortRange{{FromPort: 1, ToPort: 65536}},
+				}, "aws:pass")},
+			},
+			ExpectedError: "source_port: ports (1-65536) must be between 0 and 65535",
+		},
+		{
+			TestName: "reversed port range",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				StatelessRules: []awstypes.StatelessRule{rule(1, &awstypes.MatchAttributes{
+					DestinationPorts: []awstypes.PortRange{{FromPort: 443, ToPort: 80}},
+				}, "aws:pass")},
+			},
+			ExpectedError: "destination_port: from_port (443) must not be greater than to_port (80)",
+		},
+		{
+			TestName: "tcp flags without tcp",
+			Input: &awstypes.StatelessRulesAndCustomActions{
+				StatelessRules: []awstypes.StatelessRule{rule(1, &awstypes.MatchAttributes{
+					Protocols: []int32{17},
+					TCPFlags:  []awstypes.TCPFlagField{{Flags: []awstypes.TCPFlag{awstypes.TCPFlagSyn}}},
+				}, "aws:pass")},
+			},
+			ExpectedError: "tcp_flag requires protocols to include TCP (6)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfnetworkfirewall.ValidateRuleGroupDocument(testCase.Input)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("error %q does not contain %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+const testAccRuleGroupDocumentDataSourceConfig_basic = `
+data "aws_networkfirewall_rule_group_document" "test" {
+  custom_action {
+    action_definition {
+      publish_metric_action {
+        dimension {
+          value = "1"
+        }
+      }
+    }
+    action_name = "Counter"
+  }
+
+  stateless_rule {
+    priority = 20
+
+    rule_definition {
+      actions = ["aws:drop"]
+
+      match_attributes {
+        protocols = [17]
+
+        source {
+          address_definition = "10.0.0.0/8"
+        }
+      }
+    }
+  }
+
+  stateless_rule {
+    priority = 10
+
+    rule_definition {
+      actions = ["aws:pass", "Counter"]
+
+      match_attributes {
+        protocols = [6]
+
+        destination {
+          address_definition = "192.168.0.0/16"
+        }
+
+        destination_port {
+          from_port = 443
+        }
+
+        source_port {
+          from_port = 1024
+          to_port   = 65535
+        }
+
+        tcp_flag {
+          flags = ["SYN"]
+          masks = ["ACK", "SYN"]
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccRuleGroupDocumentExpectedJSON_basic = `{
+  "CustomActions": [
+    {
+      "ActionDefinition": {
+        "PublishMetricAction": {
+          "Dimensions": [
+            {
+              "Value": "1"
+            }
+          ]
+        }
+      },
+      "ActionName": "Counter"
+    }
+  ],
+  "StatelessRules": [
+    {
+      "Priority": 10,
+      "RuleDefinition": {
+        "Actions": ["Counter", "aws:pass"],
+        "MatchAttributes": {
+          "DestinationPorts": [
+            {
+              "FromPort": 443,
+              "ToPort": 443
+            }
+          ],
+          "Destinations": [
+            {
+              "AddressDefinition": "192.168.0.0/16"
+            }
+          ],
+          "Protocols": [6],
+          "SourcePorts": [
+            {
+              "FromPort": 1024,
+              "ToPort": 65535
+            }
+          ],
+          "TCPFlags": [
+            {
+              "Flags": ["SYN"],
+              "Masks": ["ACK", "SYN"]
+            }
+          ]
+        }
+      }
+    },
+    {
+      "Priority": 20,
+      "RuleDefinition": {
+        "Actions": ["aws:drop"],
+        "MatchAttributes": {
+          "Protocols": [17],
+          "Sources": [
+            {
+              "AddressDefinition": "10.0.0.0/8"
+            }
+          ]
+        }
+      }
+    }
+  ]
+}`
+
+const testAccRuleGroupDocumentDataSourceConfig_undefinedCustomAction = `
+data "aws_networkfirewall_rule_group_document" "test" {
+  stateless_rule {
+    priority = 1
+
+    rule_definition {
+      actions = ["aws:pass", "Undefined"]
+
+      match_attributes {
+        protocols = [6]
+      }
+    }
+  }
+}
+`
+
+const testAccRuleGroupDocumentDataSourceConfig_invalidPortRange = `
+data "aws_networkfirewall_rule_group_document" "test" {
+  stateless_rule {
+    priority = 1
+
+    rule_definition {
+      actions = ["aws:pass"]
+
+      match_attributes {
+        protocols = [6]
+
+        destination_port {
+          from_port = 443
+          to_port   = 80
+        }
+      }
+    }
+  }
+}
+`
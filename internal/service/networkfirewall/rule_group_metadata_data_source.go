@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Rule Group Metadata")
+func newRuleGroupMetadataDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &ruleGroupMetadataDataSource{}, nil
+}
+
+type ruleGroupMetadataDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*ruleGroupMetadataDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_networkfirewall_rule_group_metadata"
+}
+
+func (d *ruleGroupMetadataDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot(names.AttrName)),
+				},
+			},
+			"capacity": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_modified_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"stateful_rule_options": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[statefulRuleOptionsModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[statefulRuleOptionsModel](ctx),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RuleGroupType](),
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *ruleGroupMetadataDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data ruleGroupMetadataDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().NetworkFirewallClient(ctx)
+
+	input := &networkfirewall.DescribeRuleGroupMetadataInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findRuleGroupMetadata(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading NetworkFirewall Rule Group Metadata", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.RuleGroupArn)
+	// Normalize to UTC so that the formatted value doesn't change with the time zone of the API response.
+	if output.LastModifiedTime != nil {
+		data.LastModifiedTime = timetypes.NewRFC3339TimeValue(output.LastModifiedTime.UTC())
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findRuleGroupMetadata(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.DescribeRuleGroupMetadataInput) (*networkfirewall.DescribeRuleGroupMetadataOutput, error) {
+	output, err := conn.DescribeRuleGroupMetadata(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type ruleGroupMetadataDataSourceModel struct {
+	Capacity            types.Int64                                               `tfsdk:"capacity"`
+	Description         types.String                                              `tfsdk:"description"`
+	ID                  types.String                                              `tfsdk:"id"`
+	LastModifiedTime    timetypes.RFC3339                                         `tfsdk:"last_modified_time"`
+	RuleGroupARN        fwtypes.ARN                                               `tfsdk:"arn"`
+	RuleGroupName       types.String                                              `tfsdk:"name"`
+	StatefulRuleOptions fwtypes.ListNestedObjectValueOf[statefulRuleOptionsModel] `tfsdk:"stateful_rule_options"`
+	Type                fwtypes.StringEnum[awstypes.RuleGroupType]                `tfsdk:"type"`
+}
+
+type statefulRuleOptionsModel struct {
+	RuleOrder fwtypes.StringEnum[awstypes.RuleOrder] `tfsdk:"rule_order"`
+}
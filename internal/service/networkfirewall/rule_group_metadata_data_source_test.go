@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallRuleGroupMetadataDataSource_managed(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkfirewall_rule_group_metadata.test"
+	ruleGroupName := "MalwareDomainsStrictOrder"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupMetadataDataSourceConfig_managed(ruleGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrARN, regexache.MustCompile(`:aws-managed:stateful-rulegroup/`+ruleGroupName+`$`)),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "capacity", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, dataSourceName, names.AttrARN),
+					acctest.CheckResourceAttrRFC3339(dataSourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, ruleGroupName),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_rule_options.0.rule_order", "STRICT_ORDER"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrType, "STATEFUL"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroupMetadataDataSource_lastModifiedTimeStable(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkfirewall_rule_group_metadata.test"
+	ruleGroupName := "MalwareDomainsStrictOrder"
+	var lastModifiedTime string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupMetadataDataSourceConfig_managed(ruleGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "last_modified_time", regexache.MustCompile(`Z$`)),
+					resource.TestCheckResourceAttrWith(dataSourceName, "last_modified_time", func(value string) error {
+						lastModifiedTime = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccRuleGroupMetadataDataSourceConfig_managed(ruleGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(dataSourceName, "last_modified_time", func(value string) error {
+						if value != lastModifiedTime {
+							return fmt.Errorf("last_modified_time changed between reads: %q != %q", value, lastModifiedTime)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleGroupMetadataDataSourceConfig_managed(ruleGroupName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_networkfirewall_rule_group_metadata" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:network-firewall:${data.aws_region.current.name}:aws-managed:stateful-rulegroup/%[1]s"
+}
+`, ruleGroupName)
+}
@@ -6,8 +6,10 @@ package networkfirewall_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
@@ -21,6 +23,76 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestSuppressEquivalentSuricataRules(t *testing.T) {
+	t.Parallel()
+
+	const (
+		rule1 = `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"example.com"; nocase; sid:1; rev:1;)`
+		rule2 = `drop tcp any any -> any any (msg:"Drop all"; sid:2; rev:1;)`
+	)
+
+	testCases := map[string]struct {
+		old, new string
+		want     bool
+	}{
+		"identical": {
+			old:  rule1 + "\n" + rule2,
+			new:  rule1 + "\n" + rule2,
+			want: true,
+		},
+		"CRLF line endings": {
+			old:  rule1 + "\n" + rule2,
+			new:  rule1 + "\r\n" + rule2 + "\r\n",
+			want: true,
+		},
+		"trailing whitespace": {
+			old:  rule1 + "\n" + rule2,
+			new:  rule1 + " \t\n" + rule2 + "  ",
+			want: true,
+		},
+		"blank lines": {
+			old:  rule1 + "\n" + rule2,
+			new:  "\n" + rule1 + "\n\n\n" + rule2 + "\n\n",
+			want: true,
+		},
+		"changed rule": {
+			old:  rule1 + "\n" + rule2,
+			new:  rule1 + "\n" + strings.Replace(rule2, "drop", "alert", 1),
+			want: false,
+		},
+		"reordered rules": {
+			old:  rule1 + "\n" + rule2,
+			new:  rule2 + "\n" + rule1,
+			want: false,
+		},
+		"whitespace within rule": {
+			old:  rule1,
+			new:  strings.Replace(rule1, `"example.com"`, `"example .com"`, 1),
+			want: false,
+		},
+		"leading whitespace": {
+			old:  rule1,
+			new:  "  " + rule1,
+			want: false,
+		},
+		"added rule": {
+			old:  rule1,
+			new:  rule1 + "\n" + rule2,
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfnetworkfirewall.SuppressEquivalentSuricataRules("rules", testCase.old, testCase.new, nil); got != testCase.want {
+				t.Errorf("SuppressEquivalentSuricataRules(%q, %q) = %t, want %t", testCase.old, testCase.new, got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccNetworkFirewallRuleGroup_Basic_rulesSourceList(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -787,6 +859,24 @@ func TestAccNetworkFirewallRuleGroup_StatefulRule_action(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_StatefulRule_invalidAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_statefulAction(rName, "aws:reject"),
+				ExpectError: regexache.MustCompile(`expected action to be one of`),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16470
 func TestAccNetworkFirewallRuleGroup_StatefulRule_header(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -897,6 +987,24 @@ func TestAccNetworkFirewallRuleGroup_updateStatelessRule(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_Stateless_tcpFlagNotInMasks(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_statelessTCPFlag(rName, `"SYN", "FIN"`, `"SYN", "ACK"`),
+				ExpectError: regexache.MustCompile(`flag \(FIN\) must also be specified in masks`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -913,6 +1021,7 @@ func TestAccNetworkFirewallRuleGroup_tags(t *testing.T) {
 				Config: testAccRuleGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					testAccCheckRuleGroupCreatedWithTag(ctx, &ruleGroup, acctest.CtKey1, acctest.CtValue1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
 				),
@@ -994,6 +1103,42 @@ func TestAccNetworkFirewallRuleGroup_encryptionConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_numberOfAssociations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_basicStateful(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "number_of_associations", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_numberOfAssociations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+				),
+			},
+			{
+				// The rule group is read before the firewall policy referencing it is created.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "number_of_associations", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -1065,6 +1210,19 @@ func testAccCheckRuleGroupExists(ctx context.Context, n string, v *networkfirewa
 	}
 }
 
+// testAccCheckRuleGroupCreatedWithTag verifies that the tag is returned with the newly created rule group's description.
+func testAccCheckRuleGroupCreatedWithTag(ctx context.Context, v *networkfirewall.DescribeRuleGroupOutput, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tags := tfnetworkfirewall.KeyValueTags(ctx, v.RuleGroupResponse.Tags)
+
+		if got, ok := tags.Map()[key]; !ok || got != value {
+			return fmt.Errorf("NetworkFirewall Rule Group tag %q = %q, expected %q", key, got, value)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckRuleGroupNotRecreated(i, j *networkfirewall.DescribeRuleGroupOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before, after := aws.ToString(i.RuleGroupResponse.RuleGroupId), aws.ToString(j.RuleGroupResponse.RuleGroupId); before != after {
@@ -1350,6 +1508,23 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_numberOfAssociations(rName string) string {
+	return acctest.ConfigCompose(testAccRuleGroupConfig_basicStateful(rName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+
+    stateful_rule_group_reference {
+      resource_arn = aws_networkfirewall_rule_group.test.arn
+    }
+  }
+}
+`, rName))
+}
+
 func testAccRuleGroupConfig_statefulAction(rName, action string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1588,6 +1763,39 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_statelessTCPFlag(rName, flags, masks string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 10
+
+          rule_definition {
+            actions = ["aws:pass"]
+
+            match_attributes {
+              protocols = [6]
+
+              tcp_flag {
+                flags = [%[2]s]
+                masks = [%[3]s]
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, flags, masks)
+}
+
 func testAccRuleGroupConfig_basic(rName, rules string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
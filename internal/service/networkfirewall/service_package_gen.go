@@ -15,7 +15,24 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newEffectiveFirewallPolicyDataSource,
+			Name:    "Effective Firewall Policy",
+		},
+		{
+			Factory: newRuleGroupMetadataDataSource,
+			Name:    "Rule Group Metadata",
+		},
+		{
+			Factory: newTLSInspectionConfigurationDocumentDataSource,
+			Name:    "TLS Inspection Configuration Document",
+		},
+		{
+			Factory: newTLSInspectionConfigurationsDataSource,
+			Name:    "TLS Inspection Configurations",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -44,11 +61,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Firewall Policy",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceFirewallPolicyDocument,
+			TypeName: "aws_networkfirewall_firewall_policy_document",
+			Name:     "Firewall Policy Document",
+		},
 		{
 			Factory:  dataSourceResourcePolicy,
 			TypeName: "aws_networkfirewall_resource_policy",
 			Name:     "Resource Policy",
 		},
+		{
+			Factory:  dataSourceRuleGroupDocument,
+			TypeName: "aws_networkfirewall_rule_group_document",
+			Name:     "Rule Group Document",
+		},
 	}
 }
 
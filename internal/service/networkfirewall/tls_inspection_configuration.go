@@ -4,8 +4,12 @@
 package networkfirewall
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -13,7 +17,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -40,6 +43,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// tlsInspectionConfigurationTimeoutMinimum is the shortest create, update or delete timeout that can be configured.
+	tlsInspectionConfigurationTimeoutMinimum = 5 * time.Minute
+	// tlsInspectionConfigurationInUseTimeout is how long delete is retried while the configuration is still in use.
+	tlsInspectionConfigurationInUseTimeout = 2 * time.Minute
+)
+
 // @FrameworkResource(name="TLS Inspection Configuration")
 // @Tags(identifierAttribute="arn")
 func newTLSInspectionConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
@@ -64,7 +74,11 @@ func (*tlsInspectionConfigurationResource) Metadata(_ context.Context, request r
 
 func (r *tlsInspectionConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"allow_non_tcp_protocols": schema.BoolAttribute{
+				Optional: true,
+			},
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
 			"certificate_authority": schema.ListAttribute{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[tlsCertificateDataModel](ctx),
@@ -86,6 +100,9 @@ func (r *tlsInspectionConfigurationResource) Schema(ctx context.Context, request
 					stringvalidator.LengthBetween(1, 512),
 				},
 			},
+			"describe_json": schema.StringAttribute{
+				Computed: true,
+			},
 			names.AttrEncryptionConfiguration: schema.ListAttribute{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionConfigurationModel](ctx),
 				Optional:   true,
@@ -100,6 +117,9 @@ func (r *tlsInspectionConfigurationResource) Schema(ctx context.Context, request
 					AttrTypes: fwtypes.AttributeTypesMust[encryptionConfigurationModel](ctx),
 				},
 			},
+			"export_describe_json": schema.BoolAttribute{
+				Optional: true,
+			},
 			names.AttrID: framework.IDAttribute(),
 			names.AttrName: schema.StringAttribute{
 				Required: true,
@@ -117,6 +137,13 @@ func (r *tlsInspectionConfigurationResource) Schema(ctx context.Context, request
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"summary": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tlsInspectionConfigurationSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[tlsInspectionConfigurationSummaryModel](ctx),
+				},
+			},
 			names.AttrTags:                    tftags.TagsAttribute(),
 			names.AttrTagsAll:                 tftags.TagsAttributeComputedOnly(),
 			"tls_inspection_configuration_id": framework.IDAttribute(),
@@ -145,6 +172,9 @@ func (r *tlsInspectionConfigurationResource) Schema(ctx context.Context, request
 								listvalidator.SizeAtMost(1),
 							},
 							NestedObject: schema.NestedBlockObject{
+								Validators: []validator.Object{
+									serverCertificateConfigurationMode(),
+								},
 								Attributes: map[string]schema.Attribute{
 									"certificate_authority_arn": schema.StringAttribute{
 										CustomType: fwtypes.ARNType,
@@ -182,76 +212,71 @@ func (r *tlsInspectionConfigurationResource) Schema(ctx context.Context, request
 													ElementType: types.Int64Type,
 													Required:    true,
 													Validators: []validator.Set{
-														setvalidator.ValueInt64sAre(int64validator.Between(0, 255)),
+														setvalidator.ValueInt64sAre(protocolNumber()),
+														tlsInspectionProtocols(),
 													},
 												},
 											},
 											Blocks: map[string]schema.Block{
-												"destination_ports": schema.ListNestedBlock{
-													CustomType: fwtypes.NewListNestedObjectTypeOf[portRangeModel](ctx),
+												"destination_ports": schema.SetNestedBlock{
+													CustomType: fwtypes.NewSetNestedObjectTypeOf[portRangeModel](ctx),
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"from_port": schema.Int64Attribute{
 																Required: true,
 																Validators: []validator.Int64{
-																	int64validator.Between(0, 65535),
+																	portNumber(),
 																},
 															},
 															"to_port": schema.Int64Attribute{
 																Required: true,
 																Validators: []validator.Int64{
-																	int64validator.Between(0, 65535),
+																	portNumber(),
 																},
 															},
 														},
 													},
 												},
-												names.AttrDestination: schema.ListNestedBlock{
-													CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
-													Validators: []validator.List{
-														listvalidator.IsRequired(),
+												names.AttrDestination: schema.SetNestedBlock{
+													CustomType: fwtypes.NewSetNestedObjectTypeOf[addressModel](ctx),
+													Validators: []validator.Set{
+														setvalidator.IsRequired(),
 													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"address_definition": schema.StringAttribute{
-																Required: true,
-																Validators: []validator.String{
-																	stringvalidator.LengthBetween(1, 255),
-																	stringvalidator.RegexMatches(regexache.MustCompile(`^([a-fA-F\d:\.]+($|/\d{1,3}))$`), "Must contain IP address or a block of IP addresses in Classless Inter-Domain Routing (CIDR) notation"),
-																},
+																Required:   true,
+																Validators: addressDefinitionValidators(),
 															},
 														},
 													},
 												},
-												"source_ports": schema.ListNestedBlock{
-													CustomType: fwtypes.NewListNestedObjectTypeOf[portRangeModel](ctx),
+												"source_ports": schema.SetNestedBlock{
+													CustomType: fwtypes.NewSetNestedObjectTypeOf[portRangeModel](ctx),
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"from_port": schema.Int64Attribute{
 																Required: true,
 																Validators: []validator.Int64{
-																	int64validator.Between(0, 65535),
+																	portNumber(),
 																},
 															},
 															"to_port": schema.Int64Attribute{
 																Required: true,
 																Validators: []validator.Int64{
-																	int64validator.Between(0, 65535),
+																	portNumber(),
 																},
 															},
 														},
 													},
 												},
-												names.AttrSource: schema.ListNestedBlock{
-													CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
+												names.AttrSource: schema.SetNestedBlock{
+													CustomType: fwtypes.NewSetNestedObjectTypeOf[addressModel](ctx),
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"address_definition": schema.StringAttribute{
-																Required: true,
-																Validators: []validator.String{
-																	stringvalidator.LengthBetween(1, 255),
-																	stringvalidator.RegexMatches(regexache.MustCompile(`^([a-fA-F\d:\.]+($|/\d{1,3}))$`), "Must contain IP address or a block of IP addresses in Classless Inter-Domain Routing (CIDR) notation"),
-																},
+																Required:   true,
+																Validators: addressDefinitionValidators(),
 															},
 														},
 													},
@@ -261,6 +286,10 @@ func (r *tlsInspectionConfigurationResource) Schema(ctx context.Context, request
 									},
 									"server_certificate": schema.ListNestedBlock{
 										CustomType: fwtypes.NewListNestedObjectTypeOf[serverCertificateModel](ctx),
+										// The API has no per-certificate scoping, so each certificate must be distinct.
+										Validators: []validator.List{
+											listvalidator.UniqueValues(),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												names.AttrResourceARN: schema.StringAttribute{
@@ -296,6 +325,7 @@ func (r *tlsInspectionConfigurationResource) Create(ctx context.Context, request
 		return
 	}
 
+	input.TLSInspectionConfiguration = sortServerCertificateScopes(input.TLSInspectionConfiguration)
 	input.Tags = getTagsIn(ctx)
 
 	outputC, err := conn.CreateTLSInspectionConfiguration(ctx, input)
@@ -315,6 +345,8 @@ func (r *tlsInspectionConfigurationResource) Create(ctx context.Context, request
 	outputR, err := waitTLSInspectionConfigurationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.TLSInspectionConfigurationARN)
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for NetworkFirewall TLS Inspection Configuration (%s) create", data.ID.ValueString()), err.Error())
 
 		return
@@ -344,7 +376,7 @@ func (r *tlsInspectionConfigurationResource) Read(ctx context.Context, request r
 
 	conn := r.Meta().NetworkFirewallClient(ctx)
 
-	output, err := findTLSInspectionConfigurationByARN(ctx, conn, data.ID.ValueString())
+	output, err := findNotDeletingTLSInspectionConfigurationByARN(ctx, conn, data.ID.ValueString())
 
 	if tfresource.NotFound(err) {
 		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
@@ -392,6 +424,7 @@ func (r *tlsInspectionConfigurationResource) Update(ctx context.Context, request
 			return
 		}
 
+		input.TLSInspectionConfiguration = sortServerCertificateScopes(input.TLSInspectionConfiguration)
 		input.UpdateToken = aws.String(old.UpdateToken.ValueString())
 
 		output, err := conn.UpdateTLSInspectionConfiguration(ctx, input)
@@ -420,7 +453,24 @@ func (r *tlsInspectionConfigurationResource) Update(ctx context.Context, request
 	} else {
 		new.CertificateAuthority = old.CertificateAuthority
 		new.Certificates = old.Certificates
+		new.DescribeJSON = old.DescribeJSON
+		new.Summary = old.Summary
 		new.UpdateToken = old.UpdateToken
+
+		if !new.ExportDescribeJSON.Equal(old.ExportDescribeJSON) {
+			output, err := findTLSInspectionConfigurationByARN(ctx, conn, new.ID.ValueString())
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading NetworkFirewall TLS Inspection Configuration (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+
+			response.Diagnostics.Append(new.setDescribeJSON(output)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
@@ -435,9 +485,7 @@ func (r *tlsInspectionConfigurationResource) Delete(ctx context.Context, request
 
 	conn := r.Meta().NetworkFirewallClient(ctx)
 
-	_, err := conn.DeleteTLSInspectionConfiguration(ctx, &networkfirewall.DeleteTLSInspectionConfigurationInput{
-		TLSInspectionConfigurationArn: aws.String(data.ID.ValueString()),
-	})
+	err := deleteTLSInspectionConfiguration(ctx, conn, data.ID.ValueString(), tlsInspectionConfigurationInUseTimeout)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return
@@ -456,6 +504,17 @@ func (r *tlsInspectionConfigurationResource) Delete(ctx context.Context, request
 	}
 }
 
+func (r *tlsInspectionConfigurationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := tlsInspectionConfigurationSchema0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeTLSInspectionConfigurationResourceStateV0toV1,
+		},
+	}
+}
+
 func (r *tlsInspectionConfigurationResource) ConfigValidators(context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.AtLeastOneOf(
@@ -465,8 +524,124 @@ func (r *tlsInspectionConfigurationResource) ConfigValidators(context.Context) [
 	}
 }
 
+func (r *tlsInspectionConfigurationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data timeouts.Value
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTimeouts), &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsNull() || data.IsUnknown() {
+		return
+	}
+
+	// Certificate validation can take several minutes, so shorter timeouts are guaranteed to fail.
+	for name, value := range data.Attributes() {
+		v, ok := value.(types.String)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		// Invalid durations are reported by the timeouts block itself.
+		timeout, err := time.ParseDuration(v.ValueString())
+		if err != nil {
+			continue
+		}
+
+		if timeout < tlsInspectionConfigurationTimeoutMinimum {
+			response.Diagnostics.AddAttributeError(
+				path.Root(names.AttrTimeouts).AtName(name),
+				"Invalid Timeout",
+				fmt.Sprintf("The %s timeout (%s) must be at least %s.", name, timeout, tlsInspectionConfigurationTimeoutMinimum),
+			)
+		}
+	}
+}
+
 func (r *tlsInspectionConfigurationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data tlsInspectionConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(validateCertificateRegions(ctx, data.TLSInspectionConfiguration, r.Meta().Region)...)
+}
+
+// validateCertificateRegions ensures that the server and certificate authority certificates of a
+// tls_inspection_configuration block are in the specified Region. The certificates may be in another account,
+// but not in another Region.
+func validateCertificateRegions(ctx context.Context, v fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationModel], region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tlsInspectionConfiguration, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tlsInspectionConfiguration == nil {
+		return diags
+	}
+
+	serverCertificateConfigurations, d := tlsInspectionConfiguration.ServerCertificateConfigurations.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	validate := func(v fwtypes.ARN, path path.Path) {
+		if v.IsNull() || v.IsUnknown() {
+			return
+		}
+
+		if certificateRegion, ok := arnRegionMismatch(v.ValueString(), region); ok {
+			diags.AddAttributeError(
+				path,
+				"Certificate Region Mismatch",
+				fmt.Sprintf("The certificate (%s) is in Region %s, but the TLS inspection configuration is in Region %s.", v.ValueString(), certificateRegion, region),
+			)
+		}
+	}
+
+	configurationPath := path.Root("tls_inspection_configuration").AtListIndex(0).AtName("server_certificate_configuration")
+	for i, serverCertificateConfiguration := range serverCertificateConfigurations {
+		validate(serverCertificateConfiguration.CertificateAuthorityARN, configurationPath.AtListIndex(i).AtName("certificate_authority_arn"))
+
+		serverCertificates, d := serverCertificateConfiguration.ServerCertificates.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for j, serverCertificate := range serverCertificates {
+			validate(serverCertificate.ResourceARN, configurationPath.AtListIndex(i).AtName("server_certificate").AtListIndex(j).AtName(names.AttrResourceARN))
+		}
+	}
+
+	return diags
+}
+
+// deleteTLSInspectionConfiguration deletes a TLS inspection configuration, retrying for up to timeout
+// while it is still in use. The configuration can briefly remain in use after being removed from a firewall policy.
+func deleteTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) error {
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidOperationException](ctx, timeout, func() (interface{}, error) {
+		return conn.DeleteTLSInspectionConfiguration(ctx, &networkfirewall.DeleteTLSInspectionConfigurationInput{
+			TLSInspectionConfigurationArn: aws.String(arn),
+		})
+	}, "Unable to delete the object because it is still in use")
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidOperationException](err, "Unable to delete the object because it is still in use") {
+		return fmt.Errorf("still associated with a firewall policy after %s: %w", timeout, err)
+	}
+
+	return err
 }
 
 func findTLSInspectionConfigurationByARN(ctx context.Context, conn *networkfirewall.Client, arn string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
@@ -494,6 +669,24 @@ func findTLSInspectionConfigurationByARN(ctx context.Context, conn *networkfirew
 	return output, nil
 }
 
+// findNotDeletingTLSInspectionConfigurationByARN treats a TLS inspection configuration that is being deleted as not found.
+// The delete waiter relies on findTLSInspectionConfigurationByARN still returning such configurations.
+func findNotDeletingTLSInspectionConfigurationByARN(ctx context.Context, conn *networkfirewall.Client, arn string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	output, err := findTLSInspectionConfigurationByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationStatus; status == awstypes.ResourceStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message: string(status),
+		}
+	}
+
+	return output, nil
+}
+
 func statusTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findTLSInspectionConfigurationByARN(ctx, conn, arn)
@@ -512,6 +705,9 @@ func statusTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall
 
 const (
 	resourceStatusPending = "PENDING"
+
+	// tlsCertificateStatusError is the status of a certificate that Network Firewall could not use.
+	tlsCertificateStatusError = "ERROR"
 )
 
 func statusTLSInspectionConfigurationCertificates(ctx context.Context, conn *networkfirewall.Client, arn string) retry.StateRefreshFunc {
@@ -529,6 +725,11 @@ func statusTLSInspectionConfigurationCertificates(ctx context.Context, conn *net
 		certificates := output.TLSInspectionConfigurationResponse.Certificates
 		certificateAuthority := output.TLSInspectionConfigurationResponse.CertificateAuthority
 
+		// A certificate authority that fails to attach never recovers, so fail fast rather than waiting for the timeout.
+		if certificateAuthority != nil && aws.ToString(certificateAuthority.Status) == tlsCertificateStatusError {
+			return output, "", fmt.Errorf("certificate authority (%s): %s", aws.ToString(certificateAuthority.CertificateArn), aws.ToString(certificateAuthority.StatusMessage))
+		}
+
 		// The API does not immediately return data for certificates and certificate authority even when the resource status is "ACTIVE",
 		// which causes unexpected diffs when reading. This sets the status to "PENDING" until either the certificates or the certificate
 		// authority is populated (the API will always return at least one of the two).
@@ -600,30 +801,156 @@ func flattenDescribeTLSInspectionConfigurationOutput(ctx context.Context, data *
 		return diags
 	}
 
-	d = fwflex.Flatten(ctx, apiObject.TLSInspectionConfiguration, &data.TLSInspectionConfiguration)
+	// The certificate authority is flattened on its own so that it is kept for outbound-only configurations,
+	// which have a certificate authority but no server certificates.
+	data.CertificateAuthority, d = flattenTLSCertificateAuthority(ctx, apiObject.TLSInspectionConfigurationResponse.CertificateAuthority)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API returns either no certificates or an empty list when there are none, depending on the inspection mode.
+	// Both are stored as null so that a refresh never produces a diff between the two.
+	data.Certificates = nullIfEmptyListNestedObjectValueOf(ctx, data.Certificates)
+
+	data.Summary, d = flattenTLSInspectionConfigurationSummary(ctx, apiObject.TLSInspectionConfigurationResponse)
 	diags.Append(d...)
 	if diags.HasError() {
 		return diags
 	}
 
+	if apiObject.TLSInspectionConfiguration != nil {
+		var tlsInspectionConfiguration tlsInspectionConfigurationModel
+		d = fwflex.Flatten(ctx, apiObject.TLSInspectionConfiguration, &tlsInspectionConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		data.TLSInspectionConfiguration, d = fwtypes.NewListNestedObjectValueOfPtr(ctx, &tlsInspectionConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(data.setDescribeJSON(apiObject)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	return diags
 }
 
+func flattenTLSCertificateAuthority(ctx context.Context, apiObject *awstypes.TlsCertificateData) (fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[tlsCertificateDataModel](ctx), diags
+	}
+
+	var certificateAuthority tlsCertificateDataModel
+	diags.Append(fwflex.Flatten(ctx, apiObject, &certificateAuthority)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[tlsCertificateDataModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &certificateAuthority), diags
+}
+
+func flattenTLSInspectionConfigurationSummary(ctx context.Context, apiObject *awstypes.TLSInspectionConfigurationResponse) (fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationSummaryModel], diag.Diagnostics) {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[tlsInspectionConfigurationSummaryModel](ctx), nil
+	}
+
+	var certificateAuthorityCount int64
+	if apiObject.CertificateAuthority != nil {
+		certificateAuthorityCount = 1
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, &tlsInspectionConfigurationSummaryModel{
+		ARN:                       fwflex.StringToFramework(ctx, apiObject.TLSInspectionConfigurationArn),
+		CertificateAuthorityCount: types.Int64Value(certificateAuthorityCount),
+		CertificateCount:          types.Int64Value(int64(len(apiObject.Certificates))),
+		Name:                      fwflex.StringToFramework(ctx, apiObject.TLSInspectionConfigurationName),
+		NumberOfAssociations:      types.Int64Value(int64(aws.ToInt32(apiObject.NumberOfAssociations))),
+		Status:                    fwflex.StringValueToFramework(ctx, apiObject.TLSInspectionConfigurationStatus),
+	})
+}
+
+func nullIfEmptyListNestedObjectValueOf[T any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T]) fwtypes.ListNestedObjectValueOf[T] {
+	if !v.IsNull() && !v.IsUnknown() && len(v.Elements()) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[T](ctx)
+	}
+
+	return v
+}
+
+// sortServerCertificateScopes returns a copy of apiObject with the addresses and port ranges of each scope sorted,
+// so that the same configuration is always sent to the API in the same order.
+func sortServerCertificateScopes(apiObject *awstypes.TLSInspectionConfiguration) *awstypes.TLSInspectionConfiguration {
+	if apiObject == nil {
+		return nil
+	}
+
+	result := *apiObject
+	result.ServerCertificateConfigurations = slices.Clone(apiObject.ServerCertificateConfigurations)
+
+	for i := range result.ServerCertificateConfigurations {
+		serverCertificateConfiguration := &result.ServerCertificateConfigurations[i]
+		serverCertificateConfiguration.Scopes = slices.Clone(serverCertificateConfiguration.Scopes)
+
+		for j := range serverCertificateConfiguration.Scopes {
+			scope := &serverCertificateConfiguration.Scopes[j]
+			scope.DestinationPorts = sortedFunc(scope.DestinationPorts, comparePortRanges)
+			scope.Destinations = sortedFunc(scope.Destinations, compareAddresses)
+			scope.SourcePorts = sortedFunc(scope.SourcePorts, comparePortRanges)
+			scope.Sources = sortedFunc(scope.Sources, compareAddresses)
+		}
+	}
+
+	return &result
+}
+
+// sortedFunc returns a sorted copy of s.
+func sortedFunc[S ~[]E, E any](s S, compare func(E, E) int) S {
+	if s == nil {
+		return nil
+	}
+
+	s = slices.Clone(s)
+	slices.SortStableFunc(s, compare)
+
+	return s
+}
+
+func compareAddresses(a, b awstypes.Address) int {
+	return strings.Compare(aws.ToString(a.AddressDefinition), aws.ToString(b.AddressDefinition))
+}
+
+func comparePortRanges(a, b awstypes.PortRange) int {
+	return cmp.Or(cmp.Compare(a.FromPort, b.FromPort), cmp.Compare(a.ToPort, b.ToPort))
+}
+
 type tlsInspectionConfigurationResourceModel struct {
-	CertificateAuthority           fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]         `tfsdk:"certificate_authority"`
-	Certificates                   fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]         `tfsdk:"certificates"`
-	Description                    types.String                                                     `tfsdk:"description"`
-	EncryptionConfiguration        fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel]    `tfsdk:"encryption_configuration"`
-	ID                             types.String                                                     `tfsdk:"id"`
-	NumberOfAssociations           types.Int64                                                      `tfsdk:"number_of_associations"`
-	Tags                           types.Map                                                        `tfsdk:"tags"`
-	TagsAll                        types.Map                                                        `tfsdk:"tags_all"`
-	Timeouts                       timeouts.Value                                                   `tfsdk:"timeouts"`
-	TLSInspectionConfiguration     fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationModel] `tfsdk:"tls_inspection_configuration"`
-	TLSInspectionConfigurationARN  types.String                                                     `tfsdk:"arn"`
-	TLSInspectionConfigurationID   types.String                                                     `tfsdk:"tls_inspection_configuration_id"`
-	TLSInspectionConfigurationName types.String                                                     `tfsdk:"name"`
-	UpdateToken                    types.String                                                     `tfsdk:"update_token"`
+	AllowNonTCPProtocols           types.Bool                                                              `tfsdk:"allow_non_tcp_protocols"`
+	CertificateAuthority           fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]                `tfsdk:"certificate_authority"`
+	Certificates                   fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]                `tfsdk:"certificates"`
+	DescribeJSON                   types.String                                                            `tfsdk:"describe_json"`
+	Description                    types.String                                                            `tfsdk:"description"`
+	EncryptionConfiguration        fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel]           `tfsdk:"encryption_configuration"`
+	ExportDescribeJSON             types.Bool                                                              `tfsdk:"export_describe_json"`
+	ID                             types.String                                                            `tfsdk:"id"`
+	NumberOfAssociations           types.Int64                                                             `tfsdk:"number_of_associations"`
+	Summary                        fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationSummaryModel] `tfsdk:"summary"`
+	Tags                           types.Map                                                               `tfsdk:"tags"`
+	TagsAll                        types.Map                                                               `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                                                          `tfsdk:"timeouts"`
+	TLSInspectionConfiguration     fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationModel]        `tfsdk:"tls_inspection_configuration"`
+	TLSInspectionConfigurationARN  types.String                                                            `tfsdk:"arn"`
+	TLSInspectionConfigurationID   types.String                                                            `tfsdk:"tls_inspection_configuration_id"`
+	TLSInspectionConfigurationName types.String                                                            `tfsdk:"name"`
+	UpdateToken                    types.String                                                            `tfsdk:"update_token"`
 }
 
 func (model *tlsInspectionConfigurationResourceModel) InitFromID() error {
@@ -636,9 +963,56 @@ func (model *tlsInspectionConfigurationResourceModel) setID() {
 	model.ID = model.TLSInspectionConfigurationARN
 }
 
+// setDescribeJSON sets describe_json to the DescribeTLSInspectionConfiguration response when export_describe_json is enabled.
+// The response contains no secret material; certificates are referenced by ARN and reported by serial number and status.
+func (model *tlsInspectionConfigurationResourceModel) setDescribeJSON(apiObject *networkfirewall.DescribeTLSInspectionConfigurationOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !model.ExportDescribeJSON.ValueBool() {
+		model.DescribeJSON = types.StringNull()
+
+		return diags
+	}
+
+	v, err := json.Marshal(struct {
+		TLSInspectionConfiguration         *awstypes.TLSInspectionConfiguration
+		TLSInspectionConfigurationResponse *awstypes.TLSInspectionConfigurationResponse
+	}{
+		TLSInspectionConfiguration:         apiObject.TLSInspectionConfiguration,
+		TLSInspectionConfigurationResponse: apiObject.TLSInspectionConfigurationResponse,
+	})
+
+	if err != nil {
+		diags.AddError("serializing NetworkFirewall TLS Inspection Configuration", err.Error())
+
+		return diags
+	}
+
+	model.DescribeJSON = types.StringValue(string(v))
+
+	return diags
+}
+
 type encryptionConfigurationModel struct {
-	KeyID types.String `tfsdk:"key_id"`
-	Type  types.String `tfsdk:"type"`
+	KeyID types.String                                `tfsdk:"key_id"`
+	Type  fwtypes.StringEnum[awstypes.EncryptionType] `tfsdk:"type"`
+}
+
+var (
+	_ fwflex.Expander = encryptionConfigurationModel{}
+)
+
+// Expand sends KeyId only for customer managed keys, as KeyId is rejected with AWS owned keys.
+func (m encryptionConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	r := &awstypes.EncryptionConfiguration{
+		Type: m.Type.ValueEnum(),
+	}
+
+	if r.Type == awstypes.EncryptionTypeCustomerKms {
+		r.KeyId = fwflex.StringFromFramework(ctx, m.KeyID)
+	}
+
+	return r, diags
 }
 
 type tlsInspectionConfigurationModel struct {
@@ -646,10 +1020,10 @@ type tlsInspectionConfigurationModel struct {
 }
 
 type serverCertificateConfigurationModel struct {
-	CertificateAuthorityARN           fwtypes.ARN                                                                   `tfsdk:"certificate_authority_arn"`
-	CheckCertificateRevocationsStatus fwtypes.ListNestedObjectValueOf[checkCertificateRevocationStatusActionsModel] `tfsdk:"check_certificate_revocation_status"`
-	Scopes                            fwtypes.ListNestedObjectValueOf[serverCertificateScopeModel]                  `tfsdk:"scope"`
-	ServerCertificates                fwtypes.ListNestedObjectValueOf[serverCertificateModel]                       `tfsdk:"server_certificate"`
+	CertificateAuthorityARN          fwtypes.ARN                                                                   `tfsdk:"certificate_authority_arn"`
+	CheckCertificateRevocationStatus fwtypes.ListNestedObjectValueOf[checkCertificateRevocationStatusActionsModel] `tfsdk:"check_certificate_revocation_status"`
+	Scopes                           fwtypes.ListNestedObjectValueOf[serverCertificateScopeModel]                  `tfsdk:"scope"`
+	ServerCertificates               fwtypes.ListNestedObjectValueOf[serverCertificateModel]                       `tfsdk:"server_certificate"`
 }
 
 type checkCertificateRevocationStatusActionsModel struct {
@@ -658,11 +1032,11 @@ type checkCertificateRevocationStatusActionsModel struct {
 }
 
 type serverCertificateScopeModel struct {
-	DestinationPorts fwtypes.ListNestedObjectValueOf[portRangeModel] `tfsdk:"destination_ports"`
-	Destinations     fwtypes.ListNestedObjectValueOf[addressModel]   `tfsdk:"destination"`
-	SourcePorts      fwtypes.ListNestedObjectValueOf[portRangeModel] `tfsdk:"source_ports"`
-	Protocols        fwtypes.SetValueOf[types.Int64]                 `tfsdk:"protocols"`
-	Sources          fwtypes.ListNestedObjectValueOf[addressModel]   `tfsdk:"source"`
+	DestinationPorts fwtypes.SetNestedObjectValueOf[portRangeModel] `tfsdk:"destination_ports"`
+	Destinations     fwtypes.SetNestedObjectValueOf[addressModel]   `tfsdk:"destination"`
+	SourcePorts      fwtypes.SetNestedObjectValueOf[portRangeModel] `tfsdk:"source_ports"`
+	Protocols        fwtypes.SetValueOf[types.Int64]                `tfsdk:"protocols"`
+	Sources          fwtypes.SetNestedObjectValueOf[addressModel]   `tfsdk:"source"`
 }
 
 type portRangeModel struct {
@@ -678,8 +1052,17 @@ type serverCertificateModel struct {
 	ResourceARN fwtypes.ARN `tfsdk:"resource_arn"`
 }
 
+type tlsInspectionConfigurationSummaryModel struct {
+	ARN                       types.String `tfsdk:"arn"`
+	CertificateAuthorityCount types.Int64  `tfsdk:"certificate_authority_count"`
+	CertificateCount          types.Int64  `tfsdk:"certificate_count"`
+	Name                      types.String `tfsdk:"name"`
+	NumberOfAssociations      types.Int64  `tfsdk:"number_of_associations"`
+	Status                    types.String `tfsdk:"status"`
+}
+
 type tlsCertificateDataModel struct {
-	CertificateARN    types.String `tfsdk:"certificate_arn"`
+	CertificateARN    fwtypes.ARN  `tfsdk:"certificate_arn"`
 	CertificateSerial types.String `tfsdk:"certificate_serial"`
 	Status            types.String `tfsdk:"status"`
 	StatusMessage     types.String `tfsdk:"status_message"`
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"strconv"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="TLS Inspection Configuration Document")
+func newTLSInspectionConfigurationDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &tlsInspectionConfigurationDocumentDataSource{}, nil
+}
+
+// tlsInspectionConfigurationDocumentDataSource validates a tls_inspection_configuration block locally, with the same
+// validations as the aws_networkfirewall_tls_inspection_configuration resource, and renders it as JSON.
+// The API has no dry-run, so no AWS resources are created or read.
+type tlsInspectionConfigurationDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*tlsInspectionConfigurationDocumentDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_networkfirewall_tls_inspection_configuration_document"
+}
+
+func (d *tlsInspectionConfigurationDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	portRangeBlock := schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[portRangeModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"from_port": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						portNumber(),
+					},
+				},
+				"to_port": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						portNumber(),
+					},
+				},
+			},
+		},
+	}
+	addressAttributes := map[string]schema.Attribute{
+		"address_definition": schema.StringAttribute{
+			Required:   true,
+			Validators: addressDefinitionValidators(),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allow_non_tcp_protocols": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tls_inspection_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tlsInspectionConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"server_certificate_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[serverCertificateConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"certificate_authority_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"check_certificate_revocation_status": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[checkCertificateRevocationStatusActionsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"revoked_status_action": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.RevocationCheckAction](),
+													Optional:   true,
+												},
+												"unknown_status_action": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.RevocationCheckAction](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+									names.AttrScope: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[serverCertificateScopeModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"protocols": schema.SetAttribute{
+													CustomType:  fwtypes.NewSetTypeOf[types.Int64](ctx),
+													ElementType: types.Int64Type,
+													Required:    true,
+													Validators: []validator.Set{
+														setvalidator.ValueInt64sAre(protocolNumber()),
+														tlsInspectionProtocols(),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"destination_ports": portRangeBlock,
+												names.AttrDestination: schema.SetNestedBlock{
+													CustomType: fwtypes.NewSetNestedObjectTypeOf[addressModel](ctx),
+													Validators: []validator.Set{
+														setvalidator.IsRequired(),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: addressAttributes,
+													},
+												},
+												"source_ports": portRangeBlock,
+												names.AttrSource: schema.SetNestedBlock{
+													CustomType: fwtypes.NewSetNestedObjectTypeOf[addressModel](ctx),
+													NestedObject: schema.NestedBlockObject{
+														Attributes: addressAttributes,
+													},
+												},
+											},
+										},
+									},
+									"server_certificate": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[serverCertificateModel](ctx),
+										Validators: []validator.List{
+											listvalidator.UniqueValues(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrResourceARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tlsInspectionConfigurationDocumentDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("tls_inspection_configuration").AtListIndex(0).AtName("server_certificate_configuration").AtListIndex(0).AtName("certificate_authority_arn"),
+			path.MatchRoot("tls_inspection_configuration").AtListIndex(0).AtName("server_certificate_configuration").AtListIndex(0).AtName("server_certificate"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("tls_inspection_configuration").AtListIndex(0).AtName("server_certificate_configuration").AtListIndex(0).AtName("certificate_authority_arn"),
+			path.MatchRoot("tls_inspection_configuration").AtListIndex(0).AtName("server_certificate_configuration").AtListIndex(0).AtName("server_certificate"),
+		),
+	}
+}
+
+func (d *tlsInspectionConfigurationDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data tlsInspectionConfigurationDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(validateCertificateRegions(ctx, data.TLSInspectionConfiguration, d.Meta().Region)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	jsonString, diags := tlsInspectionConfigurationDocument(ctx, data.TLSInspectionConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(create.StringHashcode(jsonString)))
+	data.JSON = types.StringValue(jsonString)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type tlsInspectionConfigurationDocumentDataSourceModel struct {
+	AllowNonTCPProtocols       types.Bool                                                       `tfsdk:"allow_non_tcp_protocols"`
+	ID                         types.String                                                     `tfsdk:"id"`
+	JSON                       types.String                                                     `tfsdk:"json"`
+	TLSInspectionConfiguration fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationModel] `tfsdk:"tls_inspection_configuration"`
+}
+
+// tlsInspectionConfigurationDocument returns the JSON document of a tls_inspection_configuration block
+// as it would be sent to CreateTLSInspectionConfiguration.
+func tlsInspectionConfigurationDocument(ctx context.Context, v fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationModel]) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var apiObject awstypes.TLSInspectionConfiguration
+	diags.Append(fwflex.Expand(ctx, v, &apiObject)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	jsonDoc, err := marshalDocument(sortServerCertificateScopes(&apiObject))
+
+	if err != nil {
+		diags.AddError("marshaling Network Firewall TLS Inspection Configuration document", err.Error())
+
+		return "", diags
+	}
+
+	return string(jsonDoc), diags
+}
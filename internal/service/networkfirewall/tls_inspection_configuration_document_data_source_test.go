@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallTLSInspectionConfigurationDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkfirewall_tls_inspection_configuration_document.test"
+	certificateARN := fmt.Sprintf("arn:%s:acm:%s:123456789012:certificate/12345678-1234-1234-1234-123456789012", acctest.Partition(), acctest.Region()) //lintignore:AWSAT005
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationDocumentDataSourceConfig_basic(certificateARN, "[6]", "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, fmt.Sprintf(`{
+  "ServerCertificateConfigurations": [{
+    "Scopes": [{
+      "DestinationPorts": [{"FromPort": 443, "ToPort": 443}],
+      "Destinations": [{"AddressDefinition": "0.0.0.0/0"}],
+      "Protocols": [6],
+      "Sources": [{"AddressDefinition": "10.0.0.0/16"}]
+    }],
+    "ServerCertificates": [{"ResourceArn": %[1]q}]
+  }]
+}`, certificateARN)),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationDocumentDataSourceConfig_allowNonTCPProtocols(certificateARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, fmt.Sprintf(`{
+  "ServerCertificateConfigurations": [{
+    "Scopes": [{
+      "Destinations": [{"AddressDefinition": "0.0.0.0/0"}],
+      "Protocols": [6, 17]
+    }],
+    "ServerCertificates": [{"ResourceArn": %[1]q}]
+  }]
+}`, certificateARN)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfigurationDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	certificateARN := fmt.Sprintf("arn:%s:acm:%s:123456789012:certificate/12345678-1234-1234-1234-123456789012", acctest.Partition(), acctest.Region())                   //lintignore:AWSAT005
+	alternateCertificateARN := fmt.Sprintf("arn:%s:acm:%s:123456789012:certificate/12345678-1234-1234-1234-123456789012", acctest.Partition(), acctest.AlternateRegion()) //lintignore:AWSAT005
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationDocumentDataSourceConfig_basic(certificateARN, "[6]", "10.0.0.0/33"),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value`),
+			},
+			{
+				Config:      testAccTLSInspectionConfigurationDocumentDataSourceConfig_basic(certificateARN, "[17]", "10.0.0.0/16"),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value`),
+			},
+			{
+				Config:      testAccTLSInspectionConfigurationDocumentDataSourceConfig_basic(alternateCertificateARN, "[6]", "10.0.0.0/16"),
+				ExpectError: regexache.MustCompile(`Certificate Region Mismatch`),
+			},
+			{
+				Config:      testAccTLSInspectionConfigurationDocumentDataSourceConfig_certificateAuthorityAndServerCertificate(certificateARN),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestTLSInspectionConfigurationDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	certificateARN := "arn:aws:acm:us-west-2:123456789012:certificate/test" //lintignore:AWSAT003,AWSAT005
+	apiObject := &awstypes.TLSInspectionConfiguration{
+		ServerCertificateConfigurations: []awstypes.ServerCertificateConfiguration{
+			{
+				Scopes: []awstypes.ServerCertificateScope{
+					{
+						Destinations: []awstypes.Address{{AddressDefinition: aws.String("10.1.0.0/16")}, {AddressDefinition: aws.String("10.0.0.0/16")}},
+						Protocols:    []int32{6},
+					},
+				},
+				ServerCertificates: []awstypes.ServerCertificate{{ResourceArn: aws.String(certificateARN)}},
+			},
+		},
+	}
+
+	var tlsInspectionConfiguration tfnetworkfirewall.TLSInspectionConfigurationModel
+	if diags := fwflex.Flatten(ctx, apiObject, &tlsInspectionConfiguration); diags.HasError() {
+		t.Fatalf("unexpected flatten error: %v", diags)
+	}
+
+	got, diags := tfnetworkfirewall.TLSInspectionConfigurationDocument(ctx, fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tlsInspectionConfiguration))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// Addresses are sorted, as they are when sent to the API.
+	want := `{"ServerCertificateConfigurations":[{"Scopes":[{"Destinations":[{"AddressDefinition":"10.0.0.0/16"},{"AddressDefinition":"10.1.0.0/16"}],"Protocols":[6]}],"ServerCertificates":[{"ResourceArn":"` + certificateARN + `"}]}]}`
+	if got != want {
+		t.Errorf("document = %s, want %s", got, want)
+	}
+}
+
+func testAccTLSInspectionConfigurationDocumentDataSourceConfig_basic(certificateARN, protocols, source string) string {
+	return fmt.Sprintf(`
+data "aws_networkfirewall_tls_inspection_configuration_document" "test" {
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = %[1]q
+      }
+      scope {
+        protocols = %[2]s
+        destination_ports {
+          from_port = 443
+          to_port   = 443
+        }
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+        source {
+          address_definition = %[3]q
+        }
+      }
+    }
+  }
+}
+`, certificateARN, protocols, source)
+}
+
+func testAccTLSInspectionConfigurationDocumentDataSourceConfig_allowNonTCPProtocols(certificateARN string) string {
+	return fmt.Sprintf(`
+data "aws_networkfirewall_tls_inspection_configuration_document" "test" {
+  allow_non_tcp_protocols = true
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = %[1]q
+      }
+      scope {
+        protocols = [6, 17]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, certificateARN)
+}
+
+func testAccTLSInspectionConfigurationDocumentDataSourceConfig_certificateAuthorityAndServerCertificate(certificateARN string) string {
+	return fmt.Sprintf(`
+data "aws_networkfirewall_tls_inspection_configuration_document" "test" {
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      certificate_authority_arn = %[1]q
+      server_certificate {
+        resource_arn = %[1]q
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, certificateARN)
+}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func tlsInspectionConfigurationSchema0(ctx context.Context) schema.Schema {
+	portRangeBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[portRangeModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"from_port": schema.Int64Attribute{
+					Required: true,
+				},
+				"to_port": schema.Int64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+	addressBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[addressModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"address_definition": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	return schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"certificate_authority": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tlsCertificateDataModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[tlsCertificateDataModel](ctx),
+				},
+			},
+			"certificates": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tlsCertificateDataModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[tlsCertificateDataModel](ctx),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"describe_json": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrEncryptionConfiguration: schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionConfigurationModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[encryptionConfigurationModel](ctx),
+				},
+			},
+			"export_describe_json": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"number_of_associations": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrTags:                    tftags.TagsAttribute(),
+			names.AttrTagsAll:                 tftags.TagsAttributeComputedOnly(),
+			"tls_inspection_configuration_id": framework.IDAttribute(),
+			"update_token": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"tls_inspection_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tlsInspectionConfigurationModelV0](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"server_certificate_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[serverCertificateConfigurationModelV0](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"certificate_authority_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"check_certificate_revocation_status": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[checkCertificateRevocationStatusActionsModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"revoked_status_action": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.RevocationCheckAction](),
+													Optional:   true,
+												},
+												"unknown_status_action": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.RevocationCheckAction](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+									names.AttrScope: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[serverCertificateScopeModelV0](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"protocols": schema.SetAttribute{
+													CustomType:  fwtypes.NewSetTypeOf[types.Int64](ctx),
+													ElementType: types.Int64Type,
+													Required:    true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"destination_ports":   portRangeBlock,
+												names.AttrDestination: addressBlock,
+												"source_ports":        portRangeBlock,
+												names.AttrSource:      addressBlock,
+											},
+										},
+									},
+									"server_certificate": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[serverCertificateModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrResourceARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// upgradeTLSInspectionConfigurationResourceStateV0toV1 converts the scope destination, destination_ports, source and source_ports blocks from lists to sets.
+func upgradeTLSInspectionConfigurationResourceStateV0toV1(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+	var dataV0 tlsInspectionConfigurationResourceModelV0
+	response.Diagnostics.Append(request.State.Get(ctx, &dataV0)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	dataV1 := tlsInspectionConfigurationResourceModel{
+		CertificateAuthority:           dataV0.CertificateAuthority,
+		Certificates:                   dataV0.Certificates,
+		DescribeJSON:                   dataV0.DescribeJSON,
+		Description:                    dataV0.Description,
+		EncryptionConfiguration:        dataV0.EncryptionConfiguration,
+		ExportDescribeJSON:             dataV0.ExportDescribeJSON,
+		ID:                             dataV0.ID,
+		NumberOfAssociations:           dataV0.NumberOfAssociations,
+		Summary:                        fwtypes.NewListNestedObjectValueOfNull[tlsInspectionConfigurationSummaryModel](ctx),
+		Tags:                           dataV0.Tags,
+		TagsAll:                        dataV0.TagsAll,
+		Timeouts:                       dataV0.Timeouts,
+		TLSInspectionConfigurationARN:  dataV0.TLSInspectionConfigurationARN,
+		TLSInspectionConfigurationID:   dataV0.TLSInspectionConfigurationID,
+		TLSInspectionConfigurationName: dataV0.TLSInspectionConfigurationName,
+		UpdateToken:                    dataV0.UpdateToken,
+	}
+
+	var diags diag.Diagnostics
+	dataV1.TLSInspectionConfiguration, diags = upgradeListNestedObjectV0(ctx, dataV0.TLSInspectionConfiguration, upgradeTLSInspectionConfigurationModelV0)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, dataV1)...)
+}
+
+func upgradeTLSInspectionConfigurationModelV0(ctx context.Context, v0 *tlsInspectionConfigurationModelV0) (*tlsInspectionConfigurationModel, diag.Diagnostics) {
+	serverCertificateConfigurations, diags := upgradeListNestedObjectV0(ctx, v0.ServerCertificateConfigurations, upgradeServerCertificateConfigurationModelV0)
+
+	return &tlsInspectionConfigurationModel{
+		ServerCertificateConfigurations: serverCertificateConfigurations,
+	}, diags
+}
+
+func upgradeServerCertificateConfigurationModelV0(ctx context.Context, v0 *serverCertificateConfigurationModelV0) (*serverCertificateConfigurationModel, diag.Diagnostics) {
+	scopes, diags := upgradeListNestedObjectV0(ctx, v0.Scopes, upgradeServerCertificateScopeModelV0)
+
+	return &serverCertificateConfigurationModel{
+		CertificateAuthorityARN:          v0.CertificateAuthorityARN,
+		CheckCertificateRevocationStatus: v0.CheckCertificateRevocationStatus,
+		Scopes:                           scopes,
+		ServerCertificates:               v0.ServerCertificates,
+	}, diags
+}
+
+func upgradeServerCertificateScopeModelV0(ctx context.Context, v0 *serverCertificateScopeModelV0) (*serverCertificateScopeModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	v1 := &serverCertificateScopeModel{
+		Protocols: v0.Protocols,
+	}
+
+	var d diag.Diagnostics
+	v1.DestinationPorts, d = listToSetNestedObjectV0(ctx, v0.DestinationPorts)
+	diags.Append(d...)
+	v1.Destinations, d = listToSetNestedObjectV0(ctx, v0.Destinations)
+	diags.Append(d...)
+	v1.SourcePorts, d = listToSetNestedObjectV0(ctx, v0.SourcePorts)
+	diags.Append(d...)
+	v1.Sources, d = listToSetNestedObjectV0(ctx, v0.Sources)
+	diags.Append(d...)
+
+	return v1, diags
+}
+
+// upgradeListNestedObjectV0 upgrades each element of a list nested object, keeping null lists null.
+func upgradeListNestedObjectV0[T, U any](ctx context.Context, v0 fwtypes.ListNestedObjectValueOf[T], f func(context.Context, *T) (*U, diag.Diagnostics)) (fwtypes.ListNestedObjectValueOf[U], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v0.IsNull() {
+		return fwtypes.NewListNestedObjectValueOfNull[U](ctx), diags
+	}
+
+	elemsV0, d := v0.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[U](ctx), diags
+	}
+
+	elemsV1 := make([]*U, 0, len(elemsV0))
+	for _, elemV0 := range elemsV0 {
+		elemV1, d := f(ctx, elemV0)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[U](ctx), diags
+		}
+
+		elemsV1 = append(elemsV1, elemV1)
+	}
+
+	v1, d := fwtypes.NewListNestedObjectValueOfSlice(ctx, elemsV1)
+	diags.Append(d...)
+
+	return v1, diags
+}
+
+// listToSetNestedObjectV0 converts a list nested object to a set nested object, keeping null lists null.
+func listToSetNestedObjectV0[T any](ctx context.Context, v0 fwtypes.ListNestedObjectValueOf[T]) (fwtypes.SetNestedObjectValueOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v0.IsNull() {
+		return fwtypes.NewSetNestedObjectValueOfNull[T](ctx), diags
+	}
+
+	elems, d := v0.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fwtypes.NewSetNestedObjectValueOfNull[T](ctx), diags
+	}
+
+	v1, d := fwtypes.NewSetNestedObjectValueOfSlice(ctx, elems)
+	diags.Append(d...)
+
+	return v1, diags
+}
+
+type tlsInspectionConfigurationResourceModelV0 struct {
+	CertificateAuthority           fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]           `tfsdk:"certificate_authority"`
+	Certificates                   fwtypes.ListNestedObjectValueOf[tlsCertificateDataModel]           `tfsdk:"certificates"`
+	DescribeJSON                   types.String                                                       `tfsdk:"describe_json"`
+	Description                    types.String                                                       `tfsdk:"description"`
+	EncryptionConfiguration        fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel]      `tfsdk:"encryption_configuration"`
+	ExportDescribeJSON             types.Bool                                                         `tfsdk:"export_describe_json"`
+	ID                             types.String                                                       `tfsdk:"id"`
+	NumberOfAssociations           types.Int64                                                        `tfsdk:"number_of_associations"`
+	Tags                           types.Map                                                          `tfsdk:"tags"`
+	TagsAll                        types.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                                                     `tfsdk:"timeouts"`
+	TLSInspectionConfiguration     fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationModelV0] `tfsdk:"tls_inspection_configuration"`
+	TLSInspectionConfigurationARN  types.String                                                       `tfsdk:"arn"`
+	TLSInspectionConfigurationID   types.String                                                       `tfsdk:"tls_inspection_configuration_id"`
+	TLSInspectionConfigurationName types.String                                                       `tfsdk:"name"`
+	UpdateToken                    types.String                                                       `tfsdk:"update_token"`
+}
+
+type tlsInspectionConfigurationModelV0 struct {
+	ServerCertificateConfigurations fwtypes.ListNestedObjectValueOf[serverCertificateConfigurationModelV0] `tfsdk:"server_certificate_configuration"`
+}
+
+type serverCertificateConfigurationModelV0 struct {
+	CertificateAuthorityARN          fwtypes.ARN                                                                   `tfsdk:"certificate_authority_arn"`
+	CheckCertificateRevocationStatus fwtypes.ListNestedObjectValueOf[checkCertificateRevocationStatusActionsModel] `tfsdk:"check_certificate_revocation_status"`
+	Scopes                           fwtypes.ListNestedObjectValueOf[serverCertificateScopeModelV0]                `tfsdk:"scope"`
+	ServerCertificates               fwtypes.ListNestedObjectValueOf[serverCertificateModel]                       `tfsdk:"server_certificate"`
+}
+
+type serverCertificateScopeModelV0 struct {
+	DestinationPorts fwtypes.ListNestedObjectValueOf[portRangeModel] `tfsdk:"destination_ports"`
+	Destinations     fwtypes.ListNestedObjectValueOf[addressModel]   `tfsdk:"destination"`
+	SourcePorts      fwtypes.ListNestedObjectValueOf[portRangeModel] `tfsdk:"source_ports"`
+	Protocols        fwtypes.SetValueOf[types.Int64]                 `tfsdk:"protocols"`
+	Sources          fwtypes.ListNestedObjectValueOf[addressModel]   `tfsdk:"source"`
+}
@@ -5,21 +5,716 @@ package networkfirewall_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestTLSInspectionConfigurationFlattenExpand(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	arn := "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test" //lintignore:AWSAT003,AWSAT005
+	certificateARN := "arn:aws:acm:us-west-2:123456789012:certificate/test"         //lintignore:AWSAT003,AWSAT005
+	certificateAuthorityARN := "arn:aws:acm:us-west-2:123456789012:certificate/ca"  //lintignore:AWSAT003,AWSAT005
+	apiObject := &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+		TLSInspectionConfiguration: &awstypes.TLSInspectionConfiguration{
+			ServerCertificateConfigurations: []awstypes.ServerCertificateConfiguration{
+				{
+					CertificateAuthorityArn: aws.String(certificateAuthorityARN),
+					CheckCertificateRevocationStatus: &awstypes.CheckCertificateRevocationStatusActions{
+						RevokedStatusAction: awstypes.RevocationCheckActionDrop,
+						UnknownStatusAction: awstypes.RevocationCheckActionPass,
+					},
+					Scopes: []awstypes.ServerCertificateScope{
+						{
+							DestinationPorts: []awstypes.PortRange{{FromPort: 443, ToPort: 443}},
+							Destinations:     []awstypes.Address{{AddressDefinition: aws.String("0.0.0.0/0")}, {AddressDefinition: aws.String("::/0")}},
+							Protocols:        []int32{6},
+							SourcePorts:      []awstypes.PortRange{{FromPort: 0, ToPort: 65535}},
+							Sources:          []awstypes.Address{{AddressDefinition: aws.String("10.0.0.0/16")}, {AddressDefinition: aws.String("2001:db8::/32")}},
+						},
+					},
+					ServerCertificates: []awstypes.ServerCertificate{{ResourceArn: aws.String(certificateARN)}},
+				},
+			},
+		},
+		TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{
+			Certificates: []awstypes.TlsCertificateData{
+				{
+					CertificateArn:    aws.String(certificateARN),
+					CertificateSerial: aws.String("01"),
+					Status:            aws.String("OK"),
+				},
+			},
+			Description: aws.String("testing"),
+			EncryptionConfiguration: &awstypes.EncryptionConfiguration{
+				KeyId: aws.String("AWS_OWNED_KMS_KEY"),
+				Type:  awstypes.EncryptionTypeAwsOwnedKmsKey,
+			},
+			NumberOfAssociations:             aws.Int32(0),
+			TLSInspectionConfigurationArn:    aws.String(arn),
+			TLSInspectionConfigurationId:     aws.String("test-id"),
+			TLSInspectionConfigurationName:   aws.String("test"),
+			TLSInspectionConfigurationStatus: awstypes.ResourceStatusActive,
+		},
+	}
+
+	var data tfnetworkfirewall.TLSInspectionConfigurationResourceModel
+	if diags := tfnetworkfirewall.FlattenDescribeTLSInspectionConfigurationOutput(ctx, &data, apiObject); diags.HasError() {
+		t.Fatalf("unexpected flatten error: %v", diags)
+	}
+
+	if got, want := data.TLSInspectionConfigurationARN.ValueString(), arn; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+	if got, want := data.NumberOfAssociations.ValueInt64(), int64(0); got != want {
+		t.Errorf("number_of_associations = %d, want %d", got, want)
+	}
+	if got, want := len(data.Certificates.Elements()), 1; got != want {
+		t.Errorf("len(certificates) = %d, want %d", got, want)
+	}
+
+	summary, diags := data.Summary.ToPtr(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected summary error: %v", diags)
+	}
+	if got, want := summary.ARN.ValueString(), data.TLSInspectionConfigurationARN.ValueString(); got != want {
+		t.Errorf("summary.arn = %q, want %q", got, want)
+	}
+	if got, want := summary.Name.ValueString(), data.TLSInspectionConfigurationName.ValueString(); got != want {
+		t.Errorf("summary.name = %q, want %q", got, want)
+	}
+	if got, want := summary.Status.ValueString(), string(awstypes.ResourceStatusActive); got != want {
+		t.Errorf("summary.status = %q, want %q", got, want)
+	}
+	if got, want := summary.NumberOfAssociations.ValueInt64(), data.NumberOfAssociations.ValueInt64(); got != want {
+		t.Errorf("summary.number_of_associations = %d, want %d", got, want)
+	}
+	if got, want := summary.CertificateAuthorityCount.ValueInt64(), int64(0); got != want {
+		t.Errorf("summary.certificate_authority_count = %d, want %d", got, want)
+	}
+	if got, want := summary.CertificateCount.ValueInt64(), int64(len(data.Certificates.Elements())); got != want {
+		t.Errorf("summary.certificate_count = %d, want %d", got, want)
+	}
+
+	var tlsInspectionConfiguration tfnetworkfirewall.TLSInspectionConfigurationModel
+	if diags := fwflex.Flatten(ctx, apiObject.TLSInspectionConfiguration, &tlsInspectionConfiguration); diags.HasError() {
+		t.Fatalf("unexpected flatten error: %v", diags)
+	}
+	data.TLSInspectionConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tlsInspectionConfiguration)
+
+	var input networkfirewall.UpdateTLSInspectionConfigurationInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		t.Fatalf("unexpected expand error: %v", diags)
+	}
+
+	want := networkfirewall.UpdateTLSInspectionConfigurationInput{
+		Description: apiObject.TLSInspectionConfigurationResponse.Description,
+		EncryptionConfiguration: &awstypes.EncryptionConfiguration{
+			Type: awstypes.EncryptionTypeAwsOwnedKmsKey,
+		},
+		TLSInspectionConfiguration:     apiObject.TLSInspectionConfiguration,
+		TLSInspectionConfigurationArn:  apiObject.TLSInspectionConfigurationResponse.TLSInspectionConfigurationArn,
+		TLSInspectionConfigurationName: apiObject.TLSInspectionConfigurationResponse.TLSInspectionConfigurationName,
+	}
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		networkfirewall.UpdateTLSInspectionConfigurationInput{},
+		awstypes.Address{},
+		awstypes.CheckCertificateRevocationStatusActions{},
+		awstypes.EncryptionConfiguration{},
+		awstypes.PortRange{},
+		awstypes.ServerCertificate{},
+		awstypes.ServerCertificateConfiguration{},
+		awstypes.ServerCertificateScope{},
+		awstypes.TLSInspectionConfiguration{},
+	)
+	if diff := cmp.Diff(input, want, ignoreUnexported); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestTLSInspectionConfigurationFlattenNoCertificates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	certificateAuthorityARN := "arn:aws:acm:us-west-2:123456789012:certificate/ca" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		certificateAuthority *awstypes.TlsCertificateData
+		certificates         []awstypes.TlsCertificateData
+		wantAuthority        bool
+	}{
+		"no certificates": {},
+		"empty certificates": {
+			certificates: []awstypes.TlsCertificateData{},
+		},
+		"certificate authority and empty certificates": {
+			certificateAuthority: &awstypes.TlsCertificateData{CertificateArn: aws.String(certificateAuthorityARN)},
+			certificates:         []awstypes.TlsCertificateData{},
+			wantAuthority:        true,
+		},
+		"certificate authority and no certificates": {
+			certificateAuthority: &awstypes.TlsCertificateData{CertificateArn: aws.String(certificateAuthorityARN), Status: aws.String("OK")},
+			wantAuthority:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+				TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{
+					CertificateAuthority:           testCase.certificateAuthority,
+					Certificates:                   testCase.certificates,
+					TLSInspectionConfigurationArn:  aws.String("arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test"), //lintignore:AWSAT003,AWSAT005
+					TLSInspectionConfigurationName: aws.String("test"),
+				},
+			}
+
+			// Flatten twice, as a Read after Create does, and expect the same state both times.
+			var data1, data2 tfnetworkfirewall.TLSInspectionConfigurationResourceModel
+			if diags := tfnetworkfirewall.FlattenDescribeTLSInspectionConfigurationOutput(ctx, &data1, apiObject); diags.HasError() {
+				t.Fatalf("unexpected flatten error: %v", diags)
+			}
+			apiObject.TLSInspectionConfigurationResponse.Certificates = nil
+			if diags := tfnetworkfirewall.FlattenDescribeTLSInspectionConfigurationOutput(ctx, &data2, apiObject); diags.HasError() {
+				t.Fatalf("unexpected flatten error: %v", diags)
+			}
+
+			if !data1.Certificates.IsNull() {
+				t.Errorf("certificates = %s, want null", data1.Certificates)
+			}
+			if !data1.Certificates.Equal(data2.Certificates) {
+				t.Errorf("certificates = %s, then %s on refresh", data1.Certificates, data2.Certificates)
+			}
+			if got, want := !data1.CertificateAuthority.IsNull(), testCase.wantAuthority; got != want {
+				t.Errorf("certificate_authority = %s, want set %t", data1.CertificateAuthority, want)
+			}
+			if !data1.CertificateAuthority.Equal(data2.CertificateAuthority) {
+				t.Errorf("certificate_authority = %s, then %s on refresh", data1.CertificateAuthority, data2.CertificateAuthority)
+			}
+			if testCase.wantAuthority {
+				certificateAuthority, diags := data1.CertificateAuthority.ToPtr(ctx)
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				if got, want := certificateAuthority.CertificateARN.ValueString(), certificateAuthorityARN; got != want {
+					t.Errorf("certificate_authority.0.certificate_arn = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitTLSInspectionConfigurationCreated_certificateAuthorityError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	arn := "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test" //lintignore:AWSAT003,AWSAT005
+	certificateAuthorityARN := "arn:aws:acm:us-west-2:123456789012:certificate/ca"  //lintignore:AWSAT003,AWSAT005
+	statusMessage := "The certificate authority is not valid for TLS inspection"
+	var describes int
+
+	conn := newMockDescribeTLSInspectionConfigurationClient(func(string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+		describes++
+		response := &awstypes.TLSInspectionConfigurationResponse{
+			TLSInspectionConfigurationArn:    aws.String(arn),
+			TLSInspectionConfigurationStatus: awstypes.ResourceStatusActive,
+		}
+
+		// The certificate authority is not reported on the first refresh.
+		if describes > 1 {
+			response.CertificateAuthority = &awstypes.TlsCertificateData{
+				CertificateArn: aws.String(certificateAuthorityARN),
+				Status:         aws.String("ERROR"),
+				StatusMessage:  aws.String(statusMessage),
+			}
+		}
+
+		return &networkfirewall.DescribeTLSInspectionConfigurationOutput{TLSInspectionConfigurationResponse: response}, nil
+	})
+
+	timeout := 10 * time.Minute
+	start := time.Now()
+	_, err := tfnetworkfirewall.WaitTLSInspectionConfigurationCreated(ctx, conn, arn, timeout)
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if !strings.Contains(err.Error(), statusMessage) || !strings.Contains(err.Error(), certificateAuthorityARN) {
+		t.Errorf("error = %q, want certificate authority %s and status message %q", err, certificateAuthorityARN, statusMessage)
+	}
+	if got, want := describes, 2; got != want {
+		t.Errorf("DescribeTLSInspectionConfiguration calls = %d, want %d", got, want)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Minute {
+		t.Errorf("waiter took %s, want it to fail fast", elapsed)
+	}
+}
+
+func TestWaitTLSInspectionConfigurationCreated_timeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	arn := "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test" //lintignore:AWSAT003,AWSAT005
+
+	// Certificates are never reported, so the configuration stays pending.
+	conn := newMockDescribeTLSInspectionConfigurationClient(func(string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+		return &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+			TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{
+				TLSInspectionConfigurationArn:    aws.String(arn),
+				TLSInspectionConfigurationStatus: awstypes.ResourceStatusActive,
+			},
+		}, nil
+	})
+
+	_, err := tfnetworkfirewall.WaitTLSInspectionConfigurationCreated(ctx, conn, arn, 2*time.Second)
+
+	if !tfresource.TimedOut(err) {
+		t.Fatalf("error = %v, want a timeout error", err)
+	}
+}
+
+func TestDeleteTLSInspectionConfiguration_inUseRetry(t *testing.T) {
+	t.Parallel()
+
+	const (
+		arn = "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test" //lintignore:AWSAT003,AWSAT005
+	)
+	inUseErr := &awstypes.InvalidOperationException{Message: aws.String("Unable to delete the object because it is still in use")}
+
+	testCases := map[string]struct {
+		errs          []error
+		alwaysInUse   bool
+		timeout       time.Duration
+		expectedCalls int
+		expectedError string
+	}{
+		"in use once": {
+			errs:          []error{inUseErr},
+			timeout:       time.Minute,
+			expectedCalls: 2,
+		},
+		"not in use": {
+			timeout:       time.Minute,
+			expectedCalls: 1,
+		},
+		"other invalid operation": {
+			errs:          []error{&awstypes.InvalidOperationException{Message: aws.String("Unable to delete the object")}},
+			timeout:       time.Minute,
+			expectedCalls: 1,
+			expectedError: "Unable to delete the object",
+		},
+		"still in use after timeout": {
+			alwaysInUse:   true,
+			timeout:       time.Second,
+			expectedError: "still associated with a firewall policy after 1s",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			var calls int
+			conn := newMockClient(func(_ context.Context, input any) (any, error) {
+				switch v := input.(type) {
+				case *networkfirewall.DeleteTLSInspectionConfigurationInput:
+					if got, want := aws.ToString(v.TLSInspectionConfigurationArn), arn; got != want {
+						return nil, fmt.Errorf("TLSInspectionConfigurationArn = %s, want %s", got, want)
+					}
+					calls++
+					if testCase.alwaysInUse {
+						return nil, inUseErr
+					}
+					if calls <= len(testCase.errs) {
+						return nil, testCase.errs[calls-1]
+					}
+					return &networkfirewall.DeleteTLSInspectionConfigurationOutput{}, nil
+				}
+				return nil, fmt.Errorf("unexpected operation input: %T", input)
+			})
+
+			err := tfnetworkfirewall.DeleteTLSInspectionConfiguration(ctx, conn, arn, testCase.timeout)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("error = %v, want %q", err, testCase.expectedError)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expectedCalls != 0 {
+				if got, want := calls, testCase.expectedCalls; got != want {
+					t.Errorf("DeleteTLSInspectionConfiguration calls = %d, want %d", got, want)
+				}
+			} else if calls < 2 {
+				t.Errorf("DeleteTLSInspectionConfiguration calls = %d, want it to be retried", calls)
+			}
+		})
+	}
+}
+
+func TestTLSInspectionConfigurationFlattenNoLastModifiedTime(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	apiObject := &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+		TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{
+			TLSInspectionConfigurationArn:  aws.String("arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test"), //lintignore:AWSAT003,AWSAT005
+			TLSInspectionConfigurationId:   aws.String("test"),
+			TLSInspectionConfigurationName: aws.String("test"),
+		},
+	}
+
+	data := tfnetworkfirewall.TLSInspectionConfigurationResourceModel{
+		ExportDescribeJSON: types.BoolValue(true),
+	}
+	if diags := tfnetworkfirewall.FlattenDescribeTLSInspectionConfigurationOutput(ctx, &data, apiObject); diags.HasError() {
+		t.Fatalf("unexpected flatten error: %v", diags)
+	}
+
+	if got, want := data.DescribeJSON.ValueString(), `"LastModifiedTime":null`; !strings.Contains(got, want) {
+		t.Errorf("describe_json = %s, want it to contain %s", got, want)
+	}
+}
+
+func TestFindNotDeletingTLSInspectionConfigurationByARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	arn := "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		status           awstypes.ResourceStatus
+		expectedNotFound bool
+	}{
+		"active": {
+			status: awstypes.ResourceStatusActive,
+		},
+		"deleting": {
+			status:           awstypes.ResourceStatusDeleting,
+			expectedNotFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := newMockDescribeTLSInspectionConfigurationClient(func(string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+				return &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+					TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{
+						TLSInspectionConfigurationArn:    aws.String(arn),
+						TLSInspectionConfigurationStatus: testCase.status,
+					},
+				}, nil
+			})
+
+			output, err := tfnetworkfirewall.FindNotDeletingTLSInspectionConfigurationByARN(ctx, conn, arn)
+
+			if testCase.expectedNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected not found error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got, want := output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationStatus, testCase.status; got != want {
+				t.Errorf("status = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestTLSInspectionConfigurationScopeOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	newConfiguration := func(sources []string, destinationPorts []awstypes.PortRange) *awstypes.TLSInspectionConfiguration {
+		var addresses []awstypes.Address
+		for _, v := range sources {
+			addresses = append(addresses, awstypes.Address{AddressDefinition: aws.String(v)})
+		}
+
+		return &awstypes.TLSInspectionConfiguration{
+			ServerCertificateConfigurations: []awstypes.ServerCertificateConfiguration{
+				{
+					Scopes: []awstypes.ServerCertificateScope{
+						{
+							DestinationPorts: destinationPorts,
+							Destinations:     []awstypes.Address{{AddressDefinition: aws.String("0.0.0.0/0")}},
+							Protocols:        []int32{6},
+							SourcePorts:      []awstypes.PortRange{{FromPort: 0, ToPort: 65535}},
+							Sources:          addresses,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// Configuration order.
+	configured := newConfiguration(
+		[]string{"192.168.0.0/16", "10.0.0.0/8", "172.16.0.0/12"},
+		[]awstypes.PortRange{{FromPort: 8443, ToPort: 8443}, {FromPort: 443, ToPort: 443}},
+	)
+	// API order.
+	scrambled := newConfiguration(
+		[]string{"172.16.0.0/12", "192.168.0.0/16", "10.0.0.0/8"},
+		[]awstypes.PortRange{{FromPort: 443, ToPort: 443}, {FromPort: 8443, ToPort: 8443}},
+	)
+	sorted := newConfiguration(
+		[]string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"},
+		[]awstypes.PortRange{{FromPort: 443, ToPort: 443}, {FromPort: 8443, ToPort: 8443}},
+	)
+
+	newResourceModel := func(apiObject *awstypes.TLSInspectionConfiguration) tfnetworkfirewall.TLSInspectionConfigurationResourceModel {
+		var tlsInspectionConfiguration tfnetworkfirewall.TLSInspectionConfigurationModel
+		if diags := fwflex.Flatten(ctx, apiObject, &tlsInspectionConfiguration); diags.HasError() {
+			t.Fatalf("unexpected flatten error: %v", diags)
+		}
+
+		var data tfnetworkfirewall.TLSInspectionConfigurationResourceModel
+		data.TLSInspectionConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tlsInspectionConfiguration)
+
+		return data
+	}
+	// Reordered addresses and port ranges are equal, so produce no diff.
+	for _, apiObject := range []*awstypes.TLSInspectionConfiguration{scrambled, sorted} {
+		var data tfnetworkfirewall.TLSInspectionConfigurationResourceModel
+		output := &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+			TLSInspectionConfiguration:         apiObject,
+			TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{},
+		}
+		if diags := tfnetworkfirewall.FlattenDescribeTLSInspectionConfigurationOutput(ctx, &data, output); diags.HasError() {
+			t.Fatalf("unexpected flatten error: %v", diags)
+		}
+
+		if got, want := data.TLSInspectionConfiguration, newResourceModel(configured).TLSInspectionConfiguration; !got.Equal(want) {
+			t.Errorf("tls_inspection_configuration = %v, want %v", got, want)
+		}
+	}
+
+	// Elements are always sent to the API sorted.
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		awstypes.Address{},
+		awstypes.PortRange{},
+		awstypes.ServerCertificateConfiguration{},
+		awstypes.ServerCertificateScope{},
+		awstypes.TLSInspectionConfiguration{},
+	)
+	for _, apiObject := range []*awstypes.TLSInspectionConfiguration{configured, scrambled} {
+		if diff := cmp.Diff(tfnetworkfirewall.SortServerCertificateScopes(apiObject), sorted, ignoreUnexported); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	}
+}
+
+func TestTLSInspectionConfigurationUpgradeStateV0toV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r, err := tfnetworkfirewall.ResourceTLSInspectionConfiguration(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schemaResponse fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+	upgrader := r.(fwresource.ResourceWithUpgradeState).UpgradeState(ctx)[0]
+
+	const stateV0 = `{
+  "arn": "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test",
+  "certificate_authority": null,
+  "certificates": null,
+  "describe_json": null,
+  "description": null,
+  "encryption_configuration": [{"key_id": "AWS_OWNED_KMS_KEY", "type": "AWS_OWNED_KMS_KEY"}],
+  "export_describe_json": null,
+  "id": "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/test",
+  "name": "test",
+  "number_of_associations": 0,
+  "tags": null,
+  "tags_all": null,
+  "timeouts": null,
+  "tls_inspection_configuration": [{
+    "server_certificate_configuration": [{
+      "certificate_authority_arn": null,
+      "check_certificate_revocation_status": [],
+      "scope": [{
+        "destination": [{"address_definition": "0.0.0.0/0"}],
+        "destination_ports": [{"from_port": 8443, "to_port": 8443}, {"from_port": 443, "to_port": 443}],
+        "protocols": [6],
+        "source": [{"address_definition": "192.168.0.0/16"}, {"address_definition": "10.0.0.0/8"}],
+        "source_ports": []
+      }],
+      "server_certificate": [{"resource_arn": "arn:aws:acm:us-west-2:123456789012:certificate/test"}]
+    }]
+  }],
+  "tls_inspection_configuration_id": "test-id",
+  "update_token": "token"
+}` //lintignore:AWSAT003,AWSAT005
+
+	raw, err := tftypes.ValueFromJSON([]byte(stateV0), upgrader.PriorSchema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("unexpected error decoding state: %s", err)
+	}
+
+	request := fwresource.UpgradeStateRequest{
+		State: &tfsdk.State{Raw: raw, Schema: *upgrader.PriorSchema},
+	}
+	response := fwresource.UpgradeStateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil), Schema: schemaResponse.Schema},
+	}
+	upgrader.StateUpgrader(ctx, request, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected upgrade error: %v", response.Diagnostics)
+	}
+
+	var data tfnetworkfirewall.TLSInspectionConfigurationResourceModel
+	if diags := response.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := data.UpdateToken.ValueString(), "token"; got != want {
+		t.Errorf("update_token = %q, want %q", got, want)
+	}
+
+	var apiObject *awstypes.TLSInspectionConfiguration
+	if diags := fwflex.Expand(ctx, data.TLSInspectionConfiguration, &apiObject); diags.HasError() {
+		t.Fatalf("unexpected expand error: %v", diags)
+	}
+
+	want := &awstypes.TLSInspectionConfiguration{
+		ServerCertificateConfigurations: []awstypes.ServerCertificateConfiguration{
+			{
+				Scopes: []awstypes.ServerCertificateScope{
+					{
+						DestinationPorts: []awstypes.PortRange{{FromPort: 443, ToPort: 443}, {FromPort: 8443, ToPort: 8443}},
+						Destinations:     []awstypes.Address{{AddressDefinition: aws.String("0.0.0.0/0")}},
+						Protocols:        []int32{6},
+						Sources:          []awstypes.Address{{AddressDefinition: aws.String("10.0.0.0/8")}, {AddressDefinition: aws.String("192.168.0.0/16")}},
+					},
+				},
+				ServerCertificates: []awstypes.ServerCertificate{{ResourceArn: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/test")}}, //lintignore:AWSAT003,AWSAT005
+			},
+		},
+	}
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		awstypes.Address{},
+		awstypes.PortRange{},
+		awstypes.ServerCertificate{},
+		awstypes.ServerCertificateConfiguration{},
+		awstypes.ServerCertificateScope{},
+		awstypes.TLSInspectionConfiguration{},
+	)
+	// Empty blocks are expanded to empty API objects, so check_certificate_revocation_status is checked separately.
+	ignoreFields := cmpopts.IgnoreFields(awstypes.ServerCertificateConfiguration{}, "CheckCertificateRevocationStatus")
+	if diff := cmp.Diff(tfnetworkfirewall.SortServerCertificateScopes(apiObject), want, ignoreUnexported, ignoreFields, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	tlsInspectionConfiguration, diags := data.TLSInspectionConfiguration.ToPtr(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	serverCertificateConfiguration, diags := tlsInspectionConfiguration.ServerCertificateConfigurations.ToPtr(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := len(serverCertificateConfiguration.CheckCertificateRevocationStatus.Elements()), 0; got != want {
+		t.Errorf("len(check_certificate_revocation_status) = %d, want %d", got, want)
+	}
+}
+
+func TestTLSInspectionConfigurationExpandEncryptionConfiguration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyARN := "arn:aws:kms:us-west-2:123456789012:key/test" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		apiObject *awstypes.EncryptionConfiguration
+		wantKeyID *string
+	}{
+		"AWS owned key": {
+			apiObject: &awstypes.EncryptionConfiguration{
+				KeyId: aws.String("AWS_OWNED_KMS_KEY"),
+				Type:  awstypes.EncryptionTypeAwsOwnedKmsKey,
+			},
+		},
+		"customer managed key": {
+			apiObject: &awstypes.EncryptionConfiguration{
+				KeyId: aws.String(keyARN),
+				Type:  awstypes.EncryptionTypeCustomerKms,
+			},
+			wantKeyID: aws.String(keyARN),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var encryptionConfiguration tfnetworkfirewall.EncryptionConfigurationModel
+			if diags := fwflex.Flatten(ctx, testCase.apiObject, &encryptionConfiguration); diags.HasError() {
+				t.Fatalf("unexpected flatten error: %v", diags)
+			}
+
+			var data tfnetworkfirewall.TLSInspectionConfigurationResourceModel
+			data.EncryptionConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &encryptionConfiguration)
+
+			var input networkfirewall.CreateTLSInspectionConfigurationInput
+			if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+				t.Fatalf("unexpected expand error: %v", diags)
+			}
+
+			if input.EncryptionConfiguration == nil {
+				t.Fatal("EncryptionConfiguration is nil")
+			}
+			if got, want := input.EncryptionConfiguration.Type, testCase.apiObject.Type; got != want {
+				t.Errorf("Type = %s, want %s", got, want)
+			}
+			if got, want := input.EncryptionConfiguration.KeyId, testCase.wantKeyID; aws.ToString(got) != aws.ToString(want) || (got == nil) != (want == nil) {
+				t.Errorf("KeyId = %v, want %v", aws.ToString(got), aws.ToString(want))
+			}
+		})
+	}
+}
+
 func TestAccNetworkFirewallTLSInspectionConfiguration_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
@@ -47,6 +742,13 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", "AWS_OWNED_KMS_KEY"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrSet(resourceName, "number_of_associations"),
+					resource.TestCheckResourceAttr(resourceName, "summary.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "summary.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.certificate_authority_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.certificate_count", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "summary.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "summary.0.number_of_associations", resourceName, "number_of_associations"),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.status", string(awstypes.ResourceStatusActive)),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.#", acctest.Ct1),
@@ -54,7 +756,9 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.0.address_definition", "0.0.0.0/0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "0.0.0.0/0",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.*", "6"),
@@ -76,9 +780,9 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_basic(t *testing.T) {
 	})
 }
 
-func TestAccNetworkFirewallTLSInspectionConfiguration_disappears(t *testing.T) {
+func TestAccNetworkFirewallTLSInspectionConfiguration_update(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	var v1, v2 networkfirewall.DescribeTLSInspectionConfigurationOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	commonName := acctest.RandomDomain()
 	certificateDomainName := commonName.RandomSubdomain().String()
@@ -91,23 +795,37 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_disappears(t *testing.T) {
 		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTLSInspectionConfigurationConfig_basic(rName, commonName.String(), certificateDomainName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
-					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnetworkfirewall.ResourceTLSInspectionConfiguration, resourceName),
+				Config: testAccTLSInspectionConfigurationConfig_description(rName, commonName.String(), certificateDomainName, "description1", "0.0.0.0/0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "0.0.0.0/0",
+					}),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_description(rName, commonName.String(), certificateDomainName, "description2", "10.0.0.0/8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckTLSInspectionConfigurationNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "10.0.0.0/8",
+					}),
 				),
-				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
 }
 
-func TestAccNetworkFirewallTLSInspectionConfiguration_tags(t *testing.T) {
+func TestAccNetworkFirewallTLSInspectionConfiguration_multipleServerCertificates(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	commonName := acctest.RandomDomain()
-	certificateDomainName := commonName.RandomSubdomain().String()
+	certificateDomainName1 := commonName.RandomSubdomain().String()
+	certificateDomainName2 := commonName.RandomSubdomain().String()
 	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -117,11 +835,13 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_tags(t *testing.T) {
 		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTLSInspectionConfigurationConfig_tags1(rName, commonName.String(), certificateDomainName, acctest.CtKey1, acctest.CtValue1),
-				Check: resource.ComposeTestCheckFunc(
+				Config: testAccTLSInspectionConfigurationConfig_multipleServerCertificates(rName, commonName.String(), certificateDomainName1, certificateDomainName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, "certificates.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.0.resource_arn", "aws_acm_certificate.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.1.resource_arn", "aws_acm_certificate.test2", names.AttrARN),
 				),
 			},
 			{
@@ -130,35 +850,37 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_tags(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"tls_inspection_configuration", "update_token"},
 			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_duplicateServerCertificates(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
 			{
-				Config: testAccTLSInspectionConfigurationConfig_tags2(rName, commonName.String(), certificateDomainName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
-				),
-			},
-			{
-				Config: testAccTLSInspectionConfigurationConfig_tags1(rName, commonName.String(), certificateDomainName, acctest.CtKey2, acctest.CtValue2),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
-				),
+				Config:      testAccTLSInspectionConfigurationConfig_duplicateServerCertificates(rName, commonName.String(), certificateDomainName),
+				ExpectError: regexache.MustCompile(`Duplicate List Value`),
 			},
 		},
 	})
 }
 
-func TestAccNetworkFirewallTLSInspectionConfiguration_encryptionConfiguration(t *testing.T) {
+func TestAccNetworkFirewallTLSInspectionConfiguration_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	commonName := acctest.RandomDomain()
 	certificateDomainName := commonName.RandomSubdomain().String()
 	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
-	kmsKeyResourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
@@ -167,18 +889,174 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_encryptionConfiguration(t
 		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTLSInspectionConfigurationConfig_encryptionConfiguration(rName, commonName.String(), certificateDomainName),
-				Check: resource.ComposeAggregateTestCheckFunc(
+				Config: testAccTLSInspectionConfigurationConfig_basic(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
-					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "network-firewall", regexache.MustCompile(`tls-configuration/+.`)),
-					resource.TestCheckNoResourceAttr(resourceName, "certificate_authority"),
-					resource.TestCheckResourceAttr(resourceName, "certificates.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
-					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", acctest.Ct1),
-					resource.TestCheckTypeSetElemAttrPair(resourceName, "encryption_configuration.0.key_id", kmsKeyResourceName, names.AttrARN),
-					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", "CUSTOMER_KMS"),
-					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
-					resource.TestCheckResourceAttrSet(resourceName, "number_of_associations"),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnetworkfirewall.ResourceTLSInspectionConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_deleteWithFirewallPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				// The firewall policy and the TLS inspection configuration are deleted in the same apply,
+				// immediately after the association is removed.
+				Config: testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationDestroy(ctx),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_exportDescribeJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_basic(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "describe_json"),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_exportDescribeJSON(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "export_describe_json", acctest.CtTrue),
+					resource.TestCheckResourceAttrWith(resourceName, "describe_json", func(value string) error {
+						var output networkfirewall.DescribeTLSInspectionConfigurationOutput
+						if err := json.Unmarshal([]byte(value), &output); err != nil {
+							return fmt.Errorf("describe_json is not valid JSON: %w", err)
+						}
+
+						if got, want := aws.ToString(output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationName), rName; got != want {
+							return fmt.Errorf("describe_json TLSInspectionConfigurationName = %q, want %q", got, want)
+						}
+
+						if got := len(output.TLSInspectionConfiguration.ServerCertificateConfigurations); got != 1 {
+							return fmt.Errorf("describe_json has %d server certificate configurations, want 1", got)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_tags1(rName, commonName.String(), certificateDomainName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tls_inspection_configuration", "update_token"},
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_tags2(rName, commonName.String(), certificateDomainName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_tags1(rName, commonName.String(), certificateDomainName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_encryptionConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_encryptionConfiguration(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "network-firewall", regexache.MustCompile(`tls-configuration/+.`)),
+					resource.TestCheckNoResourceAttr(resourceName, "certificate_authority"),
+					resource.TestCheckResourceAttr(resourceName, "certificates.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "encryption_configuration.0.key_id", kmsKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", "CUSTOMER_KMS"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "number_of_associations"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.#", acctest.Ct1),
@@ -186,17 +1064,25 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_encryptionConfiguration(t
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.0.address_definition", "0.0.0.0/0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "0.0.0.0/0",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.0.from_port", "443"),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.0.to_port", "8080"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.*", map[string]string{
+						"from_port": "443",
+						"to_port":   "8080",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.*", "6"),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.0.address_definition", "10.0.0.0/8"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.*", map[string]string{
+						"address_definition": "10.0.0.0/8",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.0.from_port", "1024"),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.0.to_port", "65534"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.*", map[string]string{
+						"from_port": "1024",
+						"to_port":   "65534",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.#", acctest.Ct1),
 					resource.TestCheckResourceAttrSet(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.0.resource_arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "tls_inspection_configuration_id"),
@@ -213,6 +1099,50 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_encryptionConfiguration(t
 	})
 }
 
+func TestAccNetworkFirewallTLSInspectionConfiguration_encryptionConfigurationUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_associations", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_encryptionConfigurationFirewallPolicy(rName, commonName.String(), certificateDomainName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckTLSInspectionConfigurationNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_configuration.0.key_id", kmsKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", "CUSTOMER_KMS"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_associations", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallTLSInspectionConfiguration_checkCertificateRevocationStatus(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
@@ -249,17 +1179,25 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_checkCertificateRevocation
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.unknown_status_action", "PASS"),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.0.address_definition", "0.0.0.0/0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "0.0.0.0/0",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.0.from_port", "443"),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.0.to_port", "8080"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.*", map[string]string{
+						"from_port": "443",
+						"to_port":   "8080",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.*", "6"),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.0.address_definition", "10.0.0.0/8"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.*", map[string]string{
+						"address_definition": "10.0.0.0/8",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.0.from_port", "1024"),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.0.to_port", "65534"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.*", map[string]string{
+						"from_port": "1024",
+						"to_port":   "65534",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.#", acctest.Ct1),
 					resource.TestCheckResourceAttrSet(resourceName, "tls_inspection_configuration_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
@@ -293,17 +1231,25 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_checkCertificateRevocation
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.unknown_status_action", "PASS"),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.0.address_definition", "0.0.0.0/0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "0.0.0.0/0",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.0.from_port", "443"),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.0.to_port", "8080"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_ports.*", map[string]string{
+						"from_port": "443",
+						"to_port":   "8080",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.*", "6"),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.0.address_definition", "10.0.0.0/8"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.*", map[string]string{
+						"address_definition": "10.0.0.0/8",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.0.from_port", "1024"),
-					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.0.to_port", "65534"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source_ports.*", map[string]string{
+						"from_port": "1024",
+						"to_port":   "65534",
+					}),
 					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.#", acctest.Ct1),
 					resource.TestCheckResourceAttrSet(resourceName, "tls_inspection_configuration_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
@@ -313,34 +1259,342 @@ func TestAccNetworkFirewallTLSInspectionConfiguration_checkCertificateRevocation
 	})
 }
 
-func testAccCheckTLSInspectionConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallClient(ctx)
-
-		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_networkfirewall_tls_inspection_configuration" {
-				continue
-			}
+func TestAccNetworkFirewallTLSInspectionConfiguration_ipv6Scope(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
 
-			_, err := tfnetworkfirewall.FindTLSInspectionConfigurationByARN(ctx, conn, rs.Primary.ID)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_ipv6Scope(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "0.0.0.0/0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination.*", map[string]string{
+						"address_definition": "::/0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.*", map[string]string{
+						"address_definition": "10.0.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.*", map[string]string{
+						"address_definition": "2001:db8::/32",
+					}),
+				),
+			},
+			{
+				Config:   testAccTLSInspectionConfigurationConfig_ipv6Scope(rName, commonName.String(), certificateDomainName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
 
-			if tfresource.NotFound(err) {
-				continue
-			}
+func TestAccNetworkFirewallTLSInspectionConfiguration_upgradeFromV0(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
 
-			if err != nil {
-				return err
-			}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.NetworkFirewall),
+		CheckDestroy: testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.84.0",
+					},
+				},
+				Config: testAccTLSInspectionConfigurationConfig_sources(rName, commonName.String(), certificateDomainName, "10.0.0.0/16", "2001:db8::/32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccTLSInspectionConfigurationConfig_sources(rName, commonName.String(), certificateDomainName, "10.0.0.0/16", "2001:db8::/32"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.*", map[string]string{
+						"address_definition": "10.0.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.*", map[string]string{
+						"address_definition": "2001:db8::/32",
+					}),
+				),
+			},
+		},
+	})
+}
 
-			return fmt.Errorf("NetworkFirewall TLS Inspection Configuration %s still exists", rs.Primary.ID)
-		}
+func TestAccNetworkFirewallTLSInspectionConfiguration_sourceOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
 
-		return nil
-	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_sources(rName, commonName.String(), certificateDomainName, "192.168.0.0/16", "10.0.0.0/8", "172.16.0.0/12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.source.#", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_sources(rName, commonName.String(), certificateDomainName, "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
 }
 
-func testAccCheckTLSInspectionConfigurationExists(ctx context.Context, n string, v *networkfirewall.DescribeTLSInspectionConfigurationOutput) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
+func TestAccNetworkFirewallTLSInspectionConfiguration_invalidAddressDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationConfig_destinationAddressDefinition(rName, commonName.String(), certificateDomainName, "2001:db8::/129"),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_encryptionConfigurationMultiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationConfig_encryptionConfigurationMultiple(rName, commonName.String(), certificateDomainName),
+				ExpectError: regexache.MustCompile(`encryption_configuration list must contain at most 1`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_tlsInspectionConfigurationMultiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationConfig_tlsInspectionConfigurationMultiple(rName, commonName.String(), certificateDomainName),
+				ExpectError: regexache.MustCompile(`tls_inspection_configuration list must contain at most 1`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_certificateAuthorityAndServerCertificateConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationConfig_certificateAuthorityAndServerCertificate(rName, commonName.String(), certificateDomainName),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_outboundInspection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, caKey)
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_outboundInspection(rName, caCertificate, caKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_authority.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.certificate_arn", "aws_acm_certificate.test", names.AttrARN),
+					resource.TestCheckNoResourceAttr(resourceName, "certificates"),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.certificate_authority_arn", "aws_acm_certificate.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.#", acctest.Ct0),
+				),
+			},
+			{
+				// There are no server certificates, so a refresh must not produce a diff.
+				Config: testAccTLSInspectionConfigurationConfig_outboundInspection(rName, caCertificate, caKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "certificates"),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "certificate_authority.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority.0.certificate_arn", "aws_acm_certificate.test", names.AttrARN),
+					resource.TestCheckNoResourceAttr(resourceName, "certificates"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_noInspectionMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationConfig_noInspectionMode(rName),
+				ExpectError: regexache.MustCompile(`Missing Attribute Configuration`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_serverCertificateRegionMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationConfig_serverCertificateARN(rName, fmt.Sprintf("arn:%s:acm:%s:123456789012:certificate/12345678-1234-1234-1234-123456789012", acctest.Partition(), acctest.AlternateRegion())), //lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`Certificate Region Mismatch`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_timeoutsBelowMinimum(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewall),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTLSInspectionConfigurationConfig_createTimeout(rName, commonName.String(), certificateDomainName, "1s"),
+				ExpectError: regexache.MustCompile(`Invalid Timeout`),
+			},
+		},
+	})
+}
+
+func testAccCheckTLSInspectionConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_networkfirewall_tls_inspection_configuration" {
+				continue
+			}
+
+			_, err := tfnetworkfirewall.FindTLSInspectionConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("NetworkFirewall TLS Inspection Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTLSInspectionConfigurationExists(ctx context.Context, n string, v *networkfirewall.DescribeTLSInspectionConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
@@ -360,6 +1614,15 @@ func testAccCheckTLSInspectionConfigurationExists(ctx context.Context, n string,
 	}
 }
 
+func testAccCheckTLSInspectionConfigurationNotRecreated(i, j *networkfirewall.DescribeTLSInspectionConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(i.TLSInspectionConfigurationResponse.TLSInspectionConfigurationId), aws.ToString(j.TLSInspectionConfigurationResponse.TLSInspectionConfigurationId); before != after {
+			return fmt.Errorf("NetworkFirewall TLS Inspection Configuration was recreated. got: %s, expected: %s", after, before)
+		}
+		return nil
+	}
+}
+
 func testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName string) string {
 	return fmt.Sprintf(`
 resource "aws_acmpca_certificate_authority" "test" {
@@ -375,49 +1638,389 @@ resource "aws_acmpca_certificate_authority" "test" {
     }
   }
 
-  tags = {
-    Name = %[1]q
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 2
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  certificate       = aws_acmpca_certificate.test.certificate
+  certificate_chain = aws_acmpca_certificate.test.certificate_chain
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[3]q
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [
+    aws_acmpca_certificate_authority_certificate.test,
+  ]
+}
+`, rName, commonName, certificateDomainName)
+}
+
+func testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_description(rName, commonName, certificateDomainName, description, addressDefinition string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = %[3]q
+        }
+      }
+    }
+  }
+}
+`, rName, description, addressDefinition))
+}
+
+func testAccTLSInspectionConfigurationConfig_firewallPolicy(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_arn   = aws_networkfirewall_tls_inspection_configuration.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_exportDescribeJSON(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  export_describe_json = true
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_tags1(rName, commonName, certificateDomainName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccTLSInspectionConfigurationConfig_tags2(rName, commonName, certificateDomainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccTLSInspectionConfigurationConfig_encryptionConfiguration(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name        = %[1]q
+  description = "test"
+
+  encryption_configuration {
+    key_id = aws_kms_key.test.arn
+    type   = "CUSTOMER_KMS"
+  }
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+        destination_ports {
+          from_port = 443
+          to_port   = 8080
+        }
+
+        source {
+          address_definition = "10.0.0.0/8"
+        }
+        source_ports {
+          from_port = 1024
+          to_port   = 65534
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_encryptionConfigurationFirewallPolicy(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_encryptionConfiguration(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_arn   = aws_networkfirewall_tls_inspection_configuration.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_encryptionConfigurationMultiple(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  encryption_configuration {
+    key_id = aws_kms_key.test.arn
+    type   = "CUSTOMER_KMS"
+  }
+
+  encryption_configuration {
+    key_id = "AWS_OWNED_KMS_KEY"
+    type   = "AWS_OWNED_KMS_KEY"
+  }
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_tlsInspectionConfigurationMultiple(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "10.0.0.0/8"
+        }
+      }
+    }
   }
 }
+`, rName))
+}
 
-resource "aws_acmpca_certificate" "test" {
-  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
-  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
-  signing_algorithm           = "SHA512WITHRSA"
-
-  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+func testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, commonName, certificateDomainName, revokedStatusAction, unknownStatusAction string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name        = %[1]q
+  description = "test"
 
-  validity {
-    type  = "YEARS"
-    value = 2
+  encryption_configuration {
+    key_id = "AWS_OWNED_KMS_KEY"
+    type   = "AWS_OWNED_KMS_KEY"
   }
-}
 
-resource "aws_acmpca_certificate_authority_certificate" "test" {
-  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      check_certificate_revocation_status {
+        revoked_status_action = %[2]q
+        unknown_status_action = %[3]q
+      }
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
 
-  certificate       = aws_acmpca_certificate.test.certificate
-  certificate_chain = aws_acmpca_certificate.test.certificate_chain
-}
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+        destination_ports {
+          from_port = 443
+          to_port   = 8080
+        }
 
-data "aws_partition" "current" {}
+        source {
+          address_definition = "10.0.0.0/8"
+        }
+        source_ports {
+          from_port = 1024
+          to_port   = 65534
+        }
+      }
+    }
+  }
+}
+`, rName, revokedStatusAction, unknownStatusAction))
+}
 
-resource "aws_acm_certificate" "test" {
-  domain_name               = %[3]q
-  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+func testAccTLSInspectionConfigurationConfig_createTimeout(rName, commonName, certificateDomainName, createTimeout string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
 
-  tags = {
-    Name = %[1]q
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
   }
 
-  depends_on = [
-    aws_acmpca_certificate_authority_certificate.test,
-  ]
+  timeouts {
+    create = %[2]q
+  }
 }
-`, rName, commonName, certificateDomainName)
+`, rName, createTimeout))
 }
 
-func testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName string) string {
+func testAccTLSInspectionConfigurationConfig_ipv6Scope(rName, commonName, certificateDomainName string) string {
 	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
 resource "aws_networkfirewall_tls_inspection_configuration" "test" {
   name = %[1]q
@@ -432,6 +2035,15 @@ resource "aws_networkfirewall_tls_inspection_configuration" "test" {
         destination {
           address_definition = "0.0.0.0/0"
         }
+        destination {
+          address_definition = "::/0"
+        }
+        source {
+          address_definition = "10.0.0.0/16"
+        }
+        source {
+          address_definition = "2001:db8::/32"
+        }
       }
     }
   }
@@ -439,7 +2051,15 @@ resource "aws_networkfirewall_tls_inspection_configuration" "test" {
 `, rName))
 }
 
-func testAccTLSInspectionConfigurationConfig_tags1(rName, commonName, certificateDomainName, tagKey1, tagValue1 string) string {
+func testAccTLSInspectionConfigurationConfig_sources(rName, commonName, certificateDomainName string, sources ...string) string {
+	var sourceBlocks strings.Builder
+	for _, source := range sources {
+		fmt.Fprintf(&sourceBlocks, `
+        source {
+          address_definition = %[1]q
+        }`, source)
+	}
+
 	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
 resource "aws_networkfirewall_tls_inspection_configuration" "test" {
   name = %[1]q
@@ -453,25 +2073,44 @@ resource "aws_networkfirewall_tls_inspection_configuration" "test" {
         protocols = [6]
         destination {
           address_definition = "0.0.0.0/0"
-        }
+        }%[2]s
       }
     }
   }
+}
+`, rName, sourceBlocks.String()))
+}
 
-  tags = {
-    %[2]q = %[3]q
+func testAccTLSInspectionConfigurationConfig_destinationAddressDefinition(rName, commonName, certificateDomainName, addressDefinition string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = %[2]q
+        }
+      }
+    }
   }
 }
-`, rName, tagKey1, tagValue1))
+`, rName, addressDefinition))
 }
 
-func testAccTLSInspectionConfigurationConfig_tags2(rName, commonName, certificateDomainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+func testAccTLSInspectionConfigurationConfig_certificateAuthorityAndServerCertificate(rName, commonName, certificateDomainName string) string {
 	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
 resource "aws_networkfirewall_tls_inspection_configuration" "test" {
   name = %[1]q
 
   tls_inspection_configuration {
     server_certificate_configuration {
+      certificate_authority_arn = aws_acm_certificate.test.arn
       server_certificate {
         resource_arn = aws_acm_certificate.test.arn
       }
@@ -483,102 +2122,139 @@ resource "aws_networkfirewall_tls_inspection_configuration" "test" {
       }
     }
   }
+}
+`, rName))
+}
+
+func testAccTLSInspectionConfigurationConfig_outboundInspection(rName, caCertificate, caKey string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
 
   tags = {
-    %[2]q = %[3]q
-    %[4]q = %[5]q
+    Name = %[1]q
   }
 }
-`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
-}
 
-func testAccTLSInspectionConfigurationConfig_encryptionConfiguration(rName, commonName, certificateDomainName string) string {
-	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
-resource "aws_kms_key" "test" {
-  description             = %[1]q
-  deletion_window_in_days = 7
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      certificate_authority_arn = aws_acm_certificate.test.arn
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), acctest.TLSPEMEscapeNewlines(caKey))
 }
 
+func testAccTLSInspectionConfigurationConfig_noInspectionMode(rName string) string {
+	return fmt.Sprintf(`
 resource "aws_networkfirewall_tls_inspection_configuration" "test" {
-  name        = %[1]q
-  description = "test"
+  name = %[1]q
 
-  encryption_configuration {
-    key_id = aws_kms_key.test.arn
-    type   = "CUSTOMER_KMS"
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTLSInspectionConfigurationConfig_multipleServerCertificates(rName, commonName, certificateDomainName1, certificateDomainName2 string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName1), fmt.Sprintf(`
+resource "aws_acm_certificate" "test2" {
+  domain_name               = %[2]q
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  tags = {
+    Name = %[1]q
   }
 
+  depends_on = [
+    aws_acmpca_certificate_authority_certificate.test,
+  ]
+}
+
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
   tls_inspection_configuration {
     server_certificate_configuration {
       server_certificate {
         resource_arn = aws_acm_certificate.test.arn
       }
+      server_certificate {
+        resource_arn = aws_acm_certificate.test2.arn
+      }
       scope {
         protocols = [6]
-
         destination {
           address_definition = "0.0.0.0/0"
         }
-        destination_ports {
-          from_port = 443
-          to_port   = 8080
-        }
-
-        source {
-          address_definition = "10.0.0.0/8"
-        }
-        source_ports {
-          from_port = 1024
-          to_port   = 65534
-        }
       }
     }
   }
 }
-`, rName))
+`, rName, certificateDomainName2))
 }
 
-func testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, commonName, certificateDomainName, revokedStatusAction, unknownStatusAction string) string {
+func testAccTLSInspectionConfigurationConfig_duplicateServerCertificates(rName, commonName, certificateDomainName string) string {
 	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_certificateBase(rName, commonName, certificateDomainName), fmt.Sprintf(`
 resource "aws_networkfirewall_tls_inspection_configuration" "test" {
-  name        = %[1]q
-  description = "test"
-
-  encryption_configuration {
-    key_id = "AWS_OWNED_KMS_KEY"
-    type   = "AWS_OWNED_KMS_KEY"
-  }
+  name = %[1]q
 
   tls_inspection_configuration {
     server_certificate_configuration {
-      check_certificate_revocation_status {
-        revoked_status_action = %[2]q
-        unknown_status_action = %[3]q
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
       }
       server_certificate {
         resource_arn = aws_acm_certificate.test.arn
       }
       scope {
         protocols = [6]
-
         destination {
           address_definition = "0.0.0.0/0"
         }
-        destination_ports {
-          from_port = 443
-          to_port   = 8080
-        }
+      }
+    }
+  }
+}
+`, rName))
+}
 
-        source {
-          address_definition = "10.0.0.0/8"
-        }
-        source_ports {
-          from_port = 1024
-          to_port   = 65534
+func testAccTLSInspectionConfigurationConfig_serverCertificateARN(rName, certificateARN string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = %[2]q
+      }
+      scope {
+        protocols = [6]
+        destination {
+          address_definition = "0.0.0.0/0"
         }
       }
     }
   }
 }
-`, rName, revokedStatusAction, unknownStatusAction))
+`, rName, certificateARN)
 }
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of concurrent DescribeTLSInspectionConfiguration calls.
+	tlsInspectionConfigurationsDescribeConcurrency = 5
+)
+
+// @FrameworkDataSource(name="TLS Inspection Configurations")
+func newTLSInspectionConfigurationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &tlsInspectionConfigurationsDataSource{}, nil
+}
+
+type tlsInspectionConfigurationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*tlsInspectionConfigurationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_networkfirewall_tls_inspection_configurations"
+}
+
+func (d *tlsInspectionConfigurationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"only_unassociated": schema.BoolAttribute{
+				Optional: true,
+			},
+			"tls_inspection_configurations": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tlsInspectionConfigurationMetadataModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[tlsInspectionConfigurationMetadataModel](ctx),
+				},
+			},
+		},
+	}
+}
+
+func (d *tlsInspectionConfigurationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data tlsInspectionConfigurationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().NetworkFirewallClient(ctx)
+
+	output, err := findTLSInspectionConfigurations(ctx, conn, &networkfirewall.ListTLSInspectionConfigurationsInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError("reading NetworkFirewall TLS Inspection Configurations", err.Error())
+
+		return
+	}
+
+	if data.OnlyUnassociated.ValueBool() {
+		output, err = filterUnassociatedTLSInspectionConfigurations(ctx, conn, output)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading NetworkFirewall TLS Inspection Configurations", err.Error())
+
+			return
+		}
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.TLSInspectionConfigurations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findTLSInspectionConfigurations(ctx context.Context, conn *networkfirewall.Client, input *networkfirewall.ListTLSInspectionConfigurationsInput) ([]awstypes.TLSInspectionConfigurationMetadata, error) {
+	var output []awstypes.TLSInspectionConfigurationMetadata
+
+	pages := networkfirewall.NewListTLSInspectionConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.TLSInspectionConfigurations...)
+	}
+
+	return output, nil
+}
+
+func findTLSInspectionConfigurationByName(ctx context.Context, conn *networkfirewall.Client, name string) (*awstypes.TLSInspectionConfigurationMetadata, error) {
+	output, err := findTLSInspectionConfigurations(ctx, conn, &networkfirewall.ListTLSInspectionConfigurationsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output = tfslices.Filter(output, func(v awstypes.TLSInspectionConfigurationMetadata) bool {
+		return aws.ToString(v.Name) == name
+	})
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// filterUnassociatedTLSInspectionConfigurations returns the TLS inspection configurations that are not associated with any firewall policy.
+// The number of associations is only returned by DescribeTLSInspectionConfiguration so each configuration is described,
+// with bounded concurrency to avoid API throttling.
+func filterUnassociatedTLSInspectionConfigurations(ctx context.Context, conn *networkfirewall.Client, apiObjects []awstypes.TLSInspectionConfigurationMetadata) ([]awstypes.TLSInspectionConfigurationMetadata, error) {
+	unassociated := make([]bool, len(apiObjects))
+	readErrs := make([]error, len(apiObjects))
+	sem := make(chan struct{}, tlsInspectionConfigurationsDescribeConcurrency)
+
+	var wg sync.WaitGroup
+	for i, apiObject := range apiObjects {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			arn := aws.ToString(apiObject.Arn)
+			output, err := findTLSInspectionConfigurationByARN(ctx, conn, arn)
+
+			// Deleted since being listed.
+			if tfresource.NotFound(err) {
+				return
+			}
+
+			if err != nil {
+				readErrs[i] = fmt.Errorf("reading NetworkFirewall TLS Inspection Configuration (%s): %w", arn, err)
+				return
+			}
+
+			unassociated[i] = aws.ToInt32(output.TLSInspectionConfigurationResponse.NumberOfAssociations) == 0
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(readErrs...); err != nil {
+		return nil, err
+	}
+
+	var output []awstypes.TLSInspectionConfigurationMetadata
+	for i, apiObject := range apiObjects {
+		if unassociated[i] {
+			output = append(output, apiObject)
+		}
+	}
+
+	return output, nil
+}
+
+type tlsInspectionConfigurationsDataSourceModel struct {
+	ID                          types.String                                                             `tfsdk:"id"`
+	OnlyUnassociated            types.Bool                                                               `tfsdk:"only_unassociated"`
+	TLSInspectionConfigurations fwtypes.ListNestedObjectValueOf[tlsInspectionConfigurationMetadataModel] `tfsdk:"tls_inspection_configurations"`
+}
+
+type tlsInspectionConfigurationMetadataModel struct {
+	ARN  types.String `tfsdk:"arn"`
+	Name types.String `tfsdk:"name"`
+}
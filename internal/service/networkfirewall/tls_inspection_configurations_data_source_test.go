@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/aws/smithy-go"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFilterUnassociatedTLSInspectionConfigurations(t *testing.T) {
+	t.Parallel()
+
+	const (
+		arnPrefix = "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/" //lintignore:AWSAT003,AWSAT005
+	)
+	apiObjects := []awstypes.TLSInspectionConfigurationMetadata{
+		{Arn: aws.String(arnPrefix + "associated"), Name: aws.String("associated")},
+		{Arn: aws.String(arnPrefix + "deleted"), Name: aws.String("deleted")},
+		{Arn: aws.String(arnPrefix + "unassociated"), Name: aws.String("unassociated")},
+	}
+
+	testCases := map[string]struct {
+		describeErrs  map[string]error
+		expectedNames []string
+		expectedError string
+	}{
+		"not found": {
+			describeErrs: map[string]error{
+				"deleted": &awstypes.ResourceNotFoundException{Message: aws.String("not found")},
+			},
+			expectedNames: []string{"unassociated"},
+		},
+		"access denied": {
+			describeErrs: map[string]error{
+				"deleted": &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "not authorized"},
+			},
+			expectedError: "reading NetworkFirewall TLS Inspection Configuration (" + arnPrefix + "deleted): ",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			conn := newMockDescribeTLSInspectionConfigurationClient(func(arn string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+				configName := strings.TrimPrefix(arn, arnPrefix)
+				if err, ok := testCase.describeErrs[configName]; ok {
+					return nil, err
+				}
+
+				var associations int32
+				if configName == "associated" {
+					associations = 1
+				}
+
+				return &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+					TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{
+						NumberOfAssociations:          aws.Int32(associations),
+						TLSInspectionConfigurationArn: aws.String(arn),
+					},
+				}, nil
+			})
+
+			output, err := tfnetworkfirewall.FilterUnassociatedTLSInspectionConfigurations(ctx, conn, apiObjects)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+				if !strings.Contains(err.Error(), testCase.expectedError) || !strings.Contains(err.Error(), "AccessDeniedException") {
+					t.Fatalf("expected error containing %q, got %q", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var configNames []string
+			for _, v := range output {
+				configNames = append(configNames, aws.ToString(v.Name))
+			}
+			if got, want := strings.Join(configNames, ","), strings.Join(testCase.expectedNames, ","); got != want {
+				t.Errorf("unassociated configurations = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFilterUnassociatedTLSInspectionConfigurations_concurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const (
+		arnPrefix = "arn:aws:network-firewall:us-west-2:123456789012:tls-configuration/" //lintignore:AWSAT003,AWSAT005
+	)
+	n := 4 * tfnetworkfirewall.TLSInspectionConfigurationsDescribeConcurrency
+	var apiObjects []awstypes.TLSInspectionConfigurationMetadata
+	var expectedNames []string
+	indexes := make(map[string]int)
+	for i := range n {
+		name := fmt.Sprintf("config-%02d", i)
+		apiObjects = append(apiObjects, awstypes.TLSInspectionConfigurationMetadata{Arn: aws.String(arnPrefix + name), Name: aws.String(name)})
+		indexes[arnPrefix+name] = i
+		// Every third configuration is associated with a firewall policy.
+		if i%3 != 0 {
+			expectedNames = append(expectedNames, name)
+		}
+	}
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	described := make(map[string]int)
+
+	conn := newMockDescribeTLSInspectionConfigurationClient(func(arn string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+		mu.Lock()
+		described[arn]++
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		// Finish out of order.
+		i := indexes[arn]
+		time.Sleep(time.Duration(n-i) * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		var associations int32
+		if i%3 == 0 {
+			associations = 1
+		}
+
+		return &networkfirewall.DescribeTLSInspectionConfigurationOutput{
+			TLSInspectionConfigurationResponse: &awstypes.TLSInspectionConfigurationResponse{
+				NumberOfAssociations:          aws.Int32(associations),
+				TLSInspectionConfigurationArn: aws.String(arn),
+			},
+		}, nil
+	})
+
+	output, err := tfnetworkfirewall.FilterUnassociatedTLSInspectionConfigurations(ctx, conn, apiObjects)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(described), n; got != want {
+		t.Errorf("described %d configurations, want %d", got, want)
+	}
+	for arn, count := range described {
+		if count != 1 {
+			t.Errorf("configuration %s described %d times, want 1", arn, count)
+		}
+	}
+
+	if maxInFlight > tfnetworkfirewall.TLSInspectionConfigurationsDescribeConcurrency {
+		t.Errorf("%d concurrent describes, want at most %d", maxInFlight, tfnetworkfirewall.TLSInspectionConfigurationsDescribeConcurrency)
+	}
+
+	var configNames []string
+	for _, v := range output {
+		configNames = append(configNames, aws.ToString(v.Name))
+	}
+	if got, want := strings.Join(configNames, ","), strings.Join(expectedNames, ","); got != want {
+		t.Errorf("unassociated configurations = %q, want %q", got, want)
+	}
+}
+
+// newMockDescribeTLSInspectionConfigurationClient returns a client whose DescribeTLSInspectionConfiguration
+// calls are answered by describe without sending any requests.
+func newMockDescribeTLSInspectionConfigurationClient(describe func(string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error)) *networkfirewall.Client {
+	return newMockClient(func(_ context.Context, input any) (any, error) {
+		v, ok := input.(*networkfirewall.DescribeTLSInspectionConfigurationInput)
+		if !ok {
+			return nil, fmt.Errorf("unexpected operation input: %T", input)
+		}
+
+		return describe(aws.ToString(v.TLSInspectionConfigurationArn))
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfigurationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	dataSourceName := "data.aws_networkfirewall_tls_inspection_configurations.test"
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationsDataSourceConfig_basic(rName, commonName.String(), certificateDomainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "tls_inspection_configurations.#", 1),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "tls_inspection_configurations.*.arn", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "tls_inspection_configurations.*.name", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfigurationsDataSource_onlyUnassociated(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	commonName := acctest.RandomDomain()
+	certificateDomainName := commonName.RandomSubdomain().String()
+	dataSourceName := "data.aws_networkfirewall_tls_inspection_configurations.test"
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationsDataSourceConfig_onlyUnassociated(rName, commonName.String(), certificateDomainName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "only_unassociated", acctest.CtTrue),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "tls_inspection_configurations.*.arn", resourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccTLSInspectionConfigurationsDataSourceConfig_onlyUnassociated(rName, commonName.String(), certificateDomainName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "only_unassociated", acctest.CtTrue),
+					testAccCheckTLSInspectionConfigurationsDataSourceNotContains(dataSourceName, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTLSInspectionConfigurationsDataSourceNotContains(dataSourceName, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		for k, v := range ds.Primary.Attributes {
+			if strings.HasPrefix(k, "tls_inspection_configurations.") && strings.HasSuffix(k, ".arn") && v == rs.Primary.Attributes[names.AttrARN] {
+				return fmt.Errorf("%s: unexpected TLS Inspection Configuration %s", dataSourceName, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTLSInspectionConfigurationsDataSourceConfig_basic(rName, commonName, certificateDomainName string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName), `
+data "aws_networkfirewall_tls_inspection_configurations" "test" {
+  depends_on = [aws_networkfirewall_tls_inspection_configuration.test]
+}
+`)
+}
+
+func testAccTLSInspectionConfigurationsDataSourceConfig_onlyUnassociated(rName, commonName, certificateDomainName string, associated bool) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_basic(rName, commonName, certificateDomainName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  count = %[2]t ? 1 : 0
+
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+    tls_inspection_configuration_arn   = aws_networkfirewall_tls_inspection_configuration.test.arn
+  }
+}
+
+data "aws_networkfirewall_tls_inspection_configurations" "test" {
+  only_unassociated = true
+
+  depends_on = [
+    aws_networkfirewall_tls_inspection_configuration.test,
+    aws_networkfirewall_firewall_policy.test,
+  ]
+}
+`, rName, associated))
+}
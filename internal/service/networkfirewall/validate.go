@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+var (
+	// statefulRuleHeaderVariableRegexp matches a rule variable reference in a stateful rule header.
+	statefulRuleHeaderVariableRegexp = regexache.MustCompile(`^\$[A-Za-z_][0-9A-Za-z_]*$`)
+)
+
+const (
+	// statefulRuleHeaderAny matches any address or port in a stateful rule header.
+	statefulRuleHeaderAny = "ANY"
+
+	// protocolNumberTCP is the IANA protocol number for TCP.
+	protocolNumberTCP = 6
+	// protocolNumberMin and protocolNumberMax bound the IANA protocol numbers.
+	protocolNumberMin = 0
+	protocolNumberMax = 255
+
+	// portNumberMin and portNumberMax bound the TCP and UDP port numbers.
+	portNumberMin = 0
+	portNumberMax = 65535
+
+	// ruleGroupCapacityMin is the minimum capacity of any rule group.
+	ruleGroupCapacityMin = 1
+	// ruleGroupCapacityStatelessMax is the maximum capacity of a stateless rule group.
+	// See https://docs.aws.amazon.com/network-firewall/latest/developerguide/quotas.html.
+	ruleGroupCapacityStatelessMax = 30_000
+)
+
+// validStatefulRuleHeaderAddress ensures that a stateful rule header source or destination is
+// ANY, a rule variable (e.g. $HOME_NET), a bracketed list (e.g. [10.0.0.0/8, $EXTERNAL_NET]),
+// an IPv4 or IPv6 address, or an IPv4 or IPv6 address range in CIDR notation.
+// The elements of a list are left to the API to validate.
+func validStatefulRuleHeaderAddress(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == statefulRuleHeaderAny || isStatefulRuleHeaderVariableOrList(value) {
+		return
+	}
+
+	if net.ParseIP(value) != nil {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("expected %s to be %s, a rule variable, a list, an IP address or a CIDR block, got: %s", k, statefulRuleHeaderAny, value))
+	return
+}
+
+// validStatefulRuleHeaderPort ensures that a stateful rule header source or destination port is
+// ANY, a rule variable (e.g. $HTTP_PORTS), a bracketed list (e.g. [80, 443]),
+// a single port (e.g. 1994) or a range of ports (e.g. 1990:1994).
+// The elements of a list are left to the API to validate.
+func validStatefulRuleHeaderPort(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == statefulRuleHeaderAny || isStatefulRuleHeaderVariableOrList(value) {
+		return
+	}
+
+	if _, _, err := parsePortRange(value); err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be %s, a rule variable, a list, a port (0-65535) or a port range (e.g. 1990:1994), got: %s", k, statefulRuleHeaderAny, value))
+	}
+
+	return
+}
+
+// isStatefulRuleHeaderVariableOrList returns whether a stateful rule header value is a
+// rule variable (e.g. $HOME_NET) or a non-empty bracketed list (e.g. [10.0.0.0/8, !10.0.0.1]).
+func isStatefulRuleHeaderVariableOrList(value string) bool {
+	if statefulRuleHeaderVariableRegexp.MatchString(value) {
+		return true
+	}
+
+	return len(value) > 2 && strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]")
+}
+
+// validCIDRBlock ensures that an address definition is an IPv4 or IPv6 CIDR block or, as the API also
+// accepts, a single IPv4 or IPv6 address. Host bits may be set in a CIDR block (e.g. 10.0.0.1/16) as the
+// API accepts them. addressDefinitionValidators is the Plugin Framework equivalent.
+func validCIDRBlock(v interface{}, k string) (ws []string, errors []error) {
+	return validation.All(
+		validation.StringLenBetween(1, 255),
+		validation.Any(
+			validation.IsIPAddress,
+			validation.IsCIDR,
+		),
+	)(v, k)
+}
+
+// addressDefinitionValidators returns the Plugin Framework validators for an address definition.
+// An address definition is either a single IPv4 or IPv6 address or an IPv4 or IPv6 CIDR block.
+func addressDefinitionValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, 255),
+		addressDefinitionValidator{},
+	}
+}
+
+// addressDefinitionValidator validates that a string is an IPv4 or IPv6 address or CIDR block.
+// Unlike fwvalidators.IPv4CIDRNetworkAddress and fwvalidators.IPv6CIDRNetworkAddress, host bits may be set.
+type addressDefinitionValidator struct{}
+
+func (v addressDefinitionValidator) Description(_ context.Context) string {
+	return "value must be an IPv4 or IPv6 address or CIDR block"
+}
+
+func (v addressDefinitionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v addressDefinitionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if net.ParseIP(value) != nil {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %s", request.Path, v.Description(ctx), value),
+	)
+}
+
+// validPortNumber ensures that a port is between 0 and 65535. portNumber is the Plugin Framework equivalent.
+func validPortNumber(v interface{}, k string) (ws []string, errors []error) {
+	return validation.IntBetween(portNumberMin, portNumberMax)(v, k)
+}
+
+// portNumber returns a Plugin Framework validator which ensures that a port is between 0 and 65535.
+func portNumber() validator.Int64 {
+	return int64validator.Between(portNumberMin, portNumberMax)
+}
+
+// validPortRange ensures that a port set definition is a single port (e.g. 443) or a range of ports (e.g. 1024:65535).
+func validPortRange(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, _, err := parsePortRange(value); err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a port (%d-%d) or a port range (e.g. 1024:65535), got: %s", k, portNumberMin, portNumberMax, value))
+	}
+
+	return
+}
+
+// parsePortRange parses a single port or a from:to port range. A single port is returned as a range of one port.
+func parsePortRange(s string) (int, int, error) {
+	from, to, isRange := strings.Cut(s, ":")
+	if !isRange {
+		to = from
+	}
+
+	fromPort, err := parsePort(from)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	toPort, err := parsePort(to)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if fromPort > toPort {
+		return 0, 0, fmt.Errorf("invalid port range: %q", s)
+	}
+
+	return fromPort, toPort, nil
+}
+
+func parsePort(s string) (int, error) {
+	// Reject signs and whitespace that strconv.Atoi would otherwise accept or trim.
+	if s == "" || strings.TrimLeft(s, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid port: %q", s)
+	}
+
+	port, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if port > portNumberMax {
+		return 0, fmt.Errorf("port out of range: %d", port)
+	}
+
+	return port, nil
+}
+
+// validProtocolNumber ensures that a protocol is an IANA protocol number. protocolNumber is the Plugin Framework equivalent.
+func validProtocolNumber(v interface{}, k string) (ws []string, errors []error) {
+	return validation.IntBetween(protocolNumberMin, protocolNumberMax)(v, k)
+}
+
+// protocolNumber returns a Plugin Framework validator which ensures that a protocol is an IANA protocol number.
+func protocolNumber() validator.Int64 {
+	return int64validator.Between(protocolNumberMin, protocolNumberMax)
+}
+
+// validCustomActionDimensionValue ensures that a publish metric action dimension value is between 1 and 128
+// characters long and contains only alphanumeric characters, spaces, hyphens and underscores.
+func validCustomActionDimensionValue(v interface{}, k string) (ws []string, errors []error) {
+	return validation.All(
+		validation.StringLenBetween(1, 128),
+		validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_ -]+$`), "must contain only alphanumeric characters, spaces, hyphens and underscores"),
+	)(v, k)
+}
+
+// validateTCPFlagField ensures that the flags and masks of a stateless rule TCP flag match attribute
+// are valid TCP flags and, as masks define the flags to inspect, that every flag is also a mask.
+func validateTCPFlagField(apiObject awstypes.TCPFlagField) error {
+	var errs []error
+
+	for _, v := range slices.Concat(apiObject.Flags, apiObject.Masks) {
+		if !slices.Contains(enum.EnumValues[awstypes.TCPFlag](), v) {
+			errs = append(errs, fmt.Errorf("unsupported TCP flag (%s), expected one of %s", v, strings.Join(enum.Values[awstypes.TCPFlag](), ", ")))
+		}
+	}
+
+	if len(apiObject.Masks) > 0 {
+		for _, v := range apiObject.Flags {
+			if !slices.Contains(apiObject.Masks, v) {
+				errs = append(errs, fmt.Errorf("flag (%s) must also be specified in masks", v))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateRuleGroupCapacity ensures that a rule group's capacity is within the service limits for its type.
+// Stateful rule groups have no fixed per-rule-group maximum, so only the minimum is checked and the API
+// enforces the account's capacity quotas. Unknown rule group types are left for the API to reject.
+func validateRuleGroupCapacity(ruleGroupType awstypes.RuleGroupType, capacity int) error {
+	switch ruleGroupType {
+	case awstypes.RuleGroupTypeStateless:
+		if capacity < ruleGroupCapacityMin || capacity > ruleGroupCapacityStatelessMax {
+			return fmt.Errorf("capacity (%d) for a %s rule group must be between %d and %d", capacity, ruleGroupType, ruleGroupCapacityMin, ruleGroupCapacityStatelessMax)
+		}
+	case awstypes.RuleGroupTypeStateful:
+		if capacity < ruleGroupCapacityMin {
+			return fmt.Errorf("capacity (%d) for a %s rule group must be at least %d", capacity, ruleGroupType, ruleGroupCapacityMin)
+		}
+	}
+
+	return nil
+}
+
+// arnRegionMismatch returns the Region of the specified ARN and whether it differs from the specified Region.
+// ARNs that cannot be parsed or that have no Region never mismatch.
+func arnRegionMismatch(s, region string) (string, bool) {
+	v, err := arn.Parse(s)
+	if err != nil || v.Region == "" {
+		return "", false
+	}
+
+	return v.Region, v.Region != region
+}
+
+// tlsInspectionProtocolsValidator validates that a set of protocol numbers contains only TCP.
+// When allow_non_tcp_protocols is true, other protocols are reported as warnings instead of errors.
+type tlsInspectionProtocolsValidator struct{}
+
+func (v tlsInspectionProtocolsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("TLS inspection only inspects TCP traffic, so values must be %d (TCP)", protocolNumberTCP)
+}
+
+func (v tlsInspectionProtocolsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v tlsInspectionProtocolsValidator) ValidateSet(ctx context.Context, request validator.SetRequest, response *validator.SetResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var allowNonTCPProtocols types.Bool
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("allow_non_tcp_protocols"), &allowNonTCPProtocols)...)
+	if response.Diagnostics.HasError() || allowNonTCPProtocols.IsUnknown() {
+		return
+	}
+
+	for _, element := range request.ConfigValue.Elements() {
+		protocol, ok := element.(types.Int64)
+		if !ok || protocol.IsNull() || protocol.IsUnknown() {
+			continue
+		}
+
+		if protocol.ValueInt64() == protocolNumberTCP {
+			continue
+		}
+
+		if allowNonTCPProtocols.ValueBool() {
+			response.Diagnostics.AddAttributeWarning(
+				request.Path.AtSetValue(protocol),
+				"Non-TCP TLS Inspection Protocol",
+				fmt.Sprintf("Attribute %s: %s, got: %d. The value is allowed because allow_non_tcp_protocols is true", request.Path, v.Description(ctx), protocol.ValueInt64()),
+			)
+		} else {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtSetValue(protocol),
+				"Invalid TLS Inspection Protocol",
+				fmt.Sprintf("Attribute %s: %s, got: %d", request.Path, v.Description(ctx), protocol.ValueInt64()),
+			)
+		}
+	}
+}
+
+// tlsInspectionProtocols returns a set validator which ensures that all configured
+// protocol numbers are TCP (6), the only protocol that TLS inspection supports.
+// Setting the top-level allow_non_tcp_protocols attribute to true downgrades the error to a warning.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func tlsInspectionProtocols() validator.Set {
+	return tlsInspectionProtocolsValidator{}
+}
+
+// serverCertificateConfigurationModeValidator validates that a server certificate configuration
+// either decrypts inbound traffic with server_certificate or re-encrypts outbound traffic with
+// certificate_authority_arn, but not both.
+type serverCertificateConfigurationModeValidator struct{}
+
+func (v serverCertificateConfigurationModeValidator) Description(_ context.Context) string {
+	return `"certificate_authority_arn" and "server_certificate" cannot both be specified`
+}
+
+func (v serverCertificateConfigurationModeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v serverCertificateConfigurationModeValidator) ValidateObject(ctx context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+
+	certificateAuthorityARN, ok := attributes["certificate_authority_arn"]
+	if !ok || certificateAuthorityARN.IsNull() || certificateAuthorityARN.IsUnknown() {
+		return
+	}
+
+	serverCertificate, ok := attributes["server_certificate"].(basetypes.ListValuable)
+	if !ok {
+		return
+	}
+	serverCertificates, diags := serverCertificate.ToListValue(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || serverCertificates.IsUnknown() || len(serverCertificates.Elements()) == 0 {
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Attribute %s: %s", request.Path, v.Description(ctx)),
+	)
+}
+
+// serverCertificateConfigurationMode returns an object validator which ensures that each
+// server_certificate_configuration element sets at most one of certificate_authority_arn and server_certificate.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func serverCertificateConfigurationMode() validator.Object {
+	return serverCertificateConfigurationModeValidator{}
+}
@@ -0,0 +1,912 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidStatefulRuleHeaderAddress(t *testing.T) {
+	t.Parallel()
+
+	validAddresses := []string{
+		"ANY",
+		"1.2.3.4",
+		"1.2.3.4/32",
+		"10.0.0.0/8",
+		"0.0.0.0/0",
+		"2001:db8::1",
+		"2001:db8::/32",
+		"::/0",
+		"$HOME_NET",
+		"$EXTERNAL_NET",
+		"[10.0.0.0/8,192.168.1.1]",
+		"[$HOME_NET, !10.0.0.1]",
+	}
+	for _, v := range validAddresses {
+		_, errors := validStatefulRuleHeaderAddress(v, names.AttrSource)
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid stateful rule header address: %q", v, errors)
+		}
+	}
+
+	invalidAddresses := []string{
+		"",
+		"any",
+		"1.2.3",
+		"1.2.3.4/33",
+		"256.1.1.1",
+		"2001:db8::/129",
+		"example.com",
+		"1.2.3.4 ",
+		"$",
+		"$HOME-NET",
+		"[]",
+		"[10.0.0.0/8",
+	}
+	for _, v := range invalidAddresses {
+		_, errors := validStatefulRuleHeaderAddress(v, names.AttrSource)
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid stateful rule header address", v)
+		}
+	}
+}
+
+func TestValidCustomActionDimensionValue(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		"a",
+		"ExampleValue",
+		"example-value_1",
+		"example value",
+		strings.Repeat("a", 128),
+	}
+	for _, v := range validValues {
+		_, errors := validCustomActionDimensionValue(v, names.AttrValue)
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid custom action dimension value: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		strings.Repeat("a", 129),
+		"example.value",
+		"example/value",
+		"example:value",
+		"ëxample",
+	}
+	for _, v := range invalidValues {
+		_, errors := validCustomActionDimensionValue(v, names.AttrValue)
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid custom action dimension value", v)
+		}
+	}
+}
+
+func TestValidStatefulRuleHeaderPort(t *testing.T) {
+	t.Parallel()
+
+	validPorts := []string{
+		"ANY",
+		"0",
+		"53",
+		"65535",
+		"1990:1994",
+		"1994:1994",
+		"0:65535",
+		"$HTTP_PORTS",
+		"[80,443]",
+		"[1990:1994, $HTTP_PORTS]",
+	}
+	for _, v := range validPorts {
+		_, errors := validStatefulRuleHeaderPort(v, "source_port")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid stateful rule header port: %q", v, errors)
+		}
+	}
+
+	invalidPorts := []string{
+		"",
+		"any",
+		"65536",
+		"-1",
+		"+53",
+		" 53",
+		"1994:1990",
+		"1990:",
+		":1994",
+		"1990:1994:1998",
+		"1990-1994",
+		"http",
+		"$",
+		"[]",
+		"80,443",
+	}
+	for _, v := range invalidPorts {
+		_, errors := validStatefulRuleHeaderPort(v, "source_port")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid stateful rule header port", v)
+		}
+	}
+}
+
+func TestValidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	validCIDRBlocks := []string{
+		"10.0.0.0/16",
+		"192.168.1.1",
+		"0.0.0.0/0",
+		"2001:db8::/32",
+		"2001:db8::1",
+		"10.0.0.1/16",
+		"2001:db8::1/32",
+	}
+	for _, v := range validCIDRBlocks {
+		_, errors := validCIDRBlock(v, "definition")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid CIDR block: %q", v, errors)
+		}
+	}
+
+	invalidCIDRBlocks := []string{
+		"",
+		"ANY",
+		"10.0.0.0/33",
+		"256.0.0.0/8",
+		"2001:db8::/129",
+		"example.com",
+	}
+	for _, v := range invalidCIDRBlocks {
+		_, errors := validCIDRBlock(v, "definition")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid CIDR block", v)
+		}
+	}
+}
+
+func TestAddressDefinitionValidators(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"IPv4 address": {
+			value: types.StringValue("192.168.1.1"),
+		},
+		"IPv4 CIDR block": {
+			value: types.StringValue("10.0.0.0/16"),
+		},
+		"IPv4 CIDR block with host bits": {
+			value: types.StringValue("10.0.0.1/16"),
+		},
+		"IPv6 address": {
+			value: types.StringValue("2001:db8::1"),
+		},
+		"IPv6 CIDR block": {
+			value: types.StringValue("::/0"),
+		},
+		"IPv6 CIDR block with host bits": {
+			value: types.StringValue("2001:db8::1/32"),
+		},
+		"invalid prefix length": {
+			value:       types.StringValue("2001:db8::/129"),
+			expectError: true,
+		},
+		"empty": {
+			value:       types.StringValue(""),
+			expectError: true,
+		},
+		"hostname": {
+			value:       types.StringValue("example.com"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			response := validator.StringResponse{}
+			for _, v := range addressDefinitionValidators() {
+				v.ValidateString(ctx, request, &response)
+			}
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidPortNumber(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{0, 443, 65535} {
+		_, errors := validPortNumber(v, "from_port")
+		if len(errors) != 0 {
+			t.Errorf("%d should be a valid port number: %q", v, errors)
+		}
+	}
+
+	for _, v := range []int{-1, 65536} {
+		_, errors := validPortNumber(v, "from_port")
+		if len(errors) == 0 {
+			t.Errorf("%d should be an invalid port number", v)
+		}
+	}
+}
+
+func TestValidPortRange(t *testing.T) {
+	t.Parallel()
+
+	validPortRanges := []string{
+		"0",
+		"443",
+		"65535",
+		"1024:65535",
+		"8080:8080",
+	}
+	for _, v := range validPortRanges {
+		_, errors := validPortRange(v, "definition")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid port range: %q", v, errors)
+		}
+	}
+
+	invalidPortRanges := []string{
+		"",
+		"ANY",
+		"65536",
+		"-1",
+		"8080:80",
+		"80:",
+		"80-8080",
+		"[80,443]",
+	}
+	for _, v := range invalidPortRanges {
+		_, errors := validPortRange(v, "definition")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid port range", v)
+		}
+	}
+}
+
+func TestValidProtocolNumber(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{0, 6, 17, 255} {
+		_, errors := validProtocolNumber(v, "protocols")
+		if len(errors) != 0 {
+			t.Errorf("%d should be a valid protocol number: %q", v, errors)
+		}
+	}
+
+	for _, v := range []int{-1, 256} {
+		_, errors := validProtocolNumber(v, "protocols")
+		if len(errors) == 0 {
+			t.Errorf("%d should be an invalid protocol number", v)
+		}
+	}
+}
+
+func TestPortNumberAndProtocolNumberValidators(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		validator   validator.Int64
+		value       types.Int64
+		expectError bool
+	}{
+		"port valid": {
+			validator: portNumber(),
+			value:     types.Int64Value(443),
+		},
+		"port too large": {
+			validator:   portNumber(),
+			value:       types.Int64Value(65536),
+			expectError: true,
+		},
+		"protocol valid": {
+			validator: protocolNumber(),
+			value:     types.Int64Value(6),
+		},
+		"protocol too large": {
+			validator:   protocolNumber(),
+			value:       types.Int64Value(256),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.Int64Request{
+				Path:        path.Root("test"),
+				ConfigValue: testCase.value,
+			}
+			response := validator.Int64Response{}
+			testCase.validator.ValidateInt64(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestSharedValidatorsAttached(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema  map[string]*sdkschema.Schema
+		path    []string
+		invalid interface{}
+	}{
+		"rule group stateless destination": {
+			schema:  resourceRuleGroup().SchemaMap(),
+			path:    []string{"rule_group", "rules_source", "stateless_rules_and_custom_actions", "stateless_rule", "rule_definition", "match_attributes", names.AttrDestination, "address_definition"},
+			invalid: "10.0.0.0/33",
+		},
+		"rule group stateless source": {
+			schema:  resourceRuleGroup().SchemaMap(),
+			path:    []string{"rule_group", "rules_source", "stateless_rules_and_custom_actions", "stateless_rule", "rule_definition", "match_attributes", names.AttrSource, "address_definition"},
+			invalid: "10.0.0.0/33",
+		},
+		"rule group stateless destination port": {
+			schema:  resourceRuleGroup().SchemaMap(),
+			path:    []string{"rule_group", "rules_source", "stateless_rules_and_custom_actions", "stateless_rule", "rule_definition", "match_attributes", "destination_port", "from_port"},
+			invalid: 65536,
+		},
+		"rule group stateless source port": {
+			schema:  resourceRuleGroup().SchemaMap(),
+			path:    []string{"rule_group", "rules_source", "stateless_rules_and_custom_actions", "stateless_rule", "rule_definition", "match_attributes", "source_port", "to_port"},
+			invalid: 65536,
+		},
+		"rule group stateless protocols": {
+			schema:  resourceRuleGroup().SchemaMap(),
+			path:    []string{"rule_group", "rules_source", "stateless_rules_and_custom_actions", "stateless_rule", "rule_definition", "match_attributes", "protocols"},
+			invalid: 256,
+		},
+		"rule group ip set": {
+			schema:  resourceRuleGroup().SchemaMap(),
+			path:    []string{"rule_group", "rule_variables", "ip_sets", "ip_set", "definition"},
+			invalid: "10.0.0.0/33",
+		},
+		"rule group port set": {
+			schema:  resourceRuleGroup().SchemaMap(),
+			path:    []string{"rule_group", "rule_variables", "port_sets", "port_set", "definition"},
+			invalid: "65536",
+		},
+		"firewall policy ip set": {
+			schema:  resourceFirewallPolicy().SchemaMap(),
+			path:    []string{"firewall_policy", "policy_variables", "rule_variables", "ip_set", "definition"},
+			invalid: "10.0.0.0/33",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := testCase.schema[testCase.path[0]]
+			for _, k := range testCase.path[1:] {
+				if v == nil {
+					break
+				}
+				r, ok := v.Elem.(*sdkschema.Resource)
+				if !ok {
+					t.Fatalf("%s is not a nested block", strings.Join(testCase.path, "."))
+				}
+				v = r.SchemaMap()[k]
+			}
+			if v == nil {
+				t.Fatalf("%s not found", strings.Join(testCase.path, "."))
+			}
+
+			f := v.ValidateFunc
+			if e, ok := v.Elem.(*sdkschema.Schema); ok {
+				f = e.ValidateFunc
+			}
+			if f == nil {
+				t.Fatalf("%s has no ValidateFunc", strings.Join(testCase.path, "."))
+			}
+
+			if _, errors := f(testCase.invalid, testCase.path[len(testCase.path)-1]); len(errors) == 0 {
+				t.Errorf("%s should reject %v", strings.Join(testCase.path, "."), testCase.invalid)
+			}
+		})
+	}
+}
+
+func TestTLSInspectionConfigurationSharedValidatorsAttached(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r, err := newTLSInspectionConfigurationResource(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	scope := response.Schema.Blocks["tls_inspection_configuration"].(schema.ListNestedBlock).NestedObject.
+		Blocks["server_certificate_configuration"].(schema.ListNestedBlock).NestedObject.
+		Blocks[names.AttrScope].(schema.ListNestedBlock).NestedObject
+
+	serverCertificateConfiguration := response.Schema.Blocks["tls_inspection_configuration"].(schema.ListNestedBlock).NestedObject.
+		Blocks["server_certificate_configuration"].(schema.ListNestedBlock).NestedObject
+	if !validateObject(ctx, serverCertificateConfiguration.Validators, serverCertificateConfigurationModeTestValue(types.StringValue("arn:aws:acm:us-west-2:123456789012:certificate/ca"), 1)) { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("server_certificate_configuration should reject both certificate_authority_arn and server_certificate")
+	}
+
+	protocols := scope.Attributes["protocols"].(schema.SetAttribute)
+	invalidProtocols := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(256)})
+	if !validateSet(ctx, protocols.Validators, invalidProtocols) {
+		t.Errorf("protocols should reject %s", invalidProtocols)
+	}
+
+	for _, block := range []string{"destination_ports", "source_ports"} {
+		for _, attribute := range []string{"from_port", "to_port"} {
+			port := scope.Blocks[block].(schema.SetNestedBlock).NestedObject.Attributes[attribute].(schema.Int64Attribute)
+			if !validateInt64(ctx, port.Validators, types.Int64Value(65536)) {
+				t.Errorf("%s.%s should reject 65536", block, attribute)
+			}
+		}
+	}
+}
+
+func validateSet(ctx context.Context, validators []validator.Set, value types.Set) bool {
+	for _, v := range validators {
+		response := validator.SetResponse{}
+		v.ValidateSet(ctx, validator.SetRequest{Path: path.Root("test"), ConfigValue: value}, &response)
+		if response.Diagnostics.HasError() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateObject(ctx context.Context, validators []validator.Object, value types.Object) bool {
+	for _, v := range validators {
+		response := validator.ObjectResponse{}
+		v.ValidateObject(ctx, validator.ObjectRequest{Path: path.Root("test"), ConfigValue: value}, &response)
+		if response.Diagnostics.HasError() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateInt64(ctx context.Context, validators []validator.Int64, value types.Int64) bool {
+	for _, v := range validators {
+		response := validator.Int64Response{}
+		v.ValidateInt64(ctx, validator.Int64Request{Path: path.Root("test"), ConfigValue: value}, &response)
+		if response.Diagnostics.HasError() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestARNRegionMismatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn            string
+		region         string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		"same Region": {
+			arn:            "arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+			region:         "us-west-2",                                                                           //lintignore:AWSAT003
+			expectedRegion: "us-west-2",                                                                           //lintignore:AWSAT003
+		},
+		"same Region other account": {
+			arn:            "arn:aws:acm-pca:us-west-2:210987654321:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+			region:         "us-west-2",                                                                                         //lintignore:AWSAT003
+			expectedRegion: "us-west-2",                                                                                         //lintignore:AWSAT003
+		},
+		"different Region": {
+			arn:            "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+			region:         "us-west-2",                                                                           //lintignore:AWSAT003
+			expectedRegion: "us-east-1",                                                                           //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		"no Region": {
+			arn:    "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			region: "us-west-2",                           //lintignore:AWSAT003
+		},
+		"invalid ARN": {
+			arn:    "not-an-arn",
+			region: "us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotRegion, gotOK := arnRegionMismatch(testCase.arn, testCase.region)
+
+			if got, want := gotRegion, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+			if got, want := gotOK, testCase.expectedOK; got != want {
+				t.Errorf("mismatch = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestTLSInspectionProtocolsValidator(t *testing.T) {
+	t.Parallel()
+
+	invalidProtocolDiagnostic := func(protocol int64, detail string) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("test").AtSetValue(types.Int64Value(protocol)),
+			"Invalid TLS Inspection Protocol",
+			detail,
+		)
+	}
+	nonTCPProtocolDiagnostic := func(protocol int64, detail string) diag.Diagnostic {
+		return diag.NewAttributeWarningDiagnostic(
+			path.Root("test").AtSetValue(types.Int64Value(protocol)),
+			"Non-TCP TLS Inspection Protocol",
+			detail,
+		)
+	}
+
+	testCases := map[string]struct {
+		val                  types.Set
+		allowNonTCPProtocols types.Bool
+		expectedDiagnostics  diag.Diagnostics
+	}{
+		"unknown Set": {
+			val: types.SetUnknown(types.Int64Type),
+		},
+		"null Set": {
+			val: types.SetNull(types.Int64Type),
+		},
+		"TCP": {
+			val: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(6)}),
+		},
+		"UDP": {
+			val: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(17)}),
+			expectedDiagnostics: diag.Diagnostics{
+				invalidProtocolDiagnostic(17, `Attribute test: TLS inspection only inspects TCP traffic, so values must be 6 (TCP), got: 17`),
+			},
+		},
+		"mixed": {
+			val: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(6), types.Int64Value(17)}),
+			expectedDiagnostics: diag.Diagnostics{
+				invalidProtocolDiagnostic(1, `Attribute test: TLS inspection only inspects TCP traffic, so values must be 6 (TCP), got: 1`),
+				invalidProtocolDiagnostic(17, `Attribute test: TLS inspection only inspects TCP traffic, so values must be 6 (TCP), got: 17`),
+			},
+		},
+		"unknown element": {
+			val: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Unknown(), types.Int64Value(6)}),
+		},
+		"UDP non-TCP protocols not allowed": {
+			val:                  types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(17)}),
+			allowNonTCPProtocols: types.BoolValue(false),
+			expectedDiagnostics: diag.Diagnostics{
+				invalidProtocolDiagnostic(17, `Attribute test: TLS inspection only inspects TCP traffic, so values must be 6 (TCP), got: 17`),
+			},
+		},
+		"UDP non-TCP protocols allowed": {
+			val:                  types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(6), types.Int64Value(17)}),
+			allowNonTCPProtocols: types.BoolValue(true),
+			expectedDiagnostics: diag.Diagnostics{
+				nonTCPProtocolDiagnostic(17, `Attribute test: TLS inspection only inspects TCP traffic, so values must be 6 (TCP), got: 17. The value is allowed because allow_non_tcp_protocols is true`),
+			},
+		},
+		"UDP non-TCP protocols unknown": {
+			val:                  types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(17)}),
+			allowNonTCPProtocols: types.BoolUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    testCase.val,
+				Config:         tlsInspectionProtocolsValidatorTestConfig(ctx, t, testCase.val, testCase.allowNonTCPProtocols),
+			}
+			response := validator.SetResponse{}
+			tlsInspectionProtocols().ValidateSet(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func tlsInspectionProtocolsValidatorTestConfig(ctx context.Context, t *testing.T, protocols types.Set, allowNonTCPProtocols types.Bool) tfsdk.Config {
+	t.Helper()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allow_non_tcp_protocols": schema.BoolAttribute{
+				Optional: true,
+			},
+			"test": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+		},
+	}
+
+	raw, err := types.ObjectValueMust(
+		map[string]attr.Type{
+			"allow_non_tcp_protocols": types.BoolType,
+			"test":                    types.SetType{ElemType: types.Int64Type},
+		},
+		map[string]attr.Value{
+			"allow_non_tcp_protocols": allowNonTCPProtocols,
+			"test":                    protocols,
+		},
+	).ToTerraformValue(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return tfsdk.Config{
+		Raw:    raw,
+		Schema: testSchema,
+	}
+}
+
+func TestServerCertificateConfigurationModeValidator(t *testing.T) {
+	t.Parallel()
+
+	certificateAuthorityARN := types.StringValue("arn:aws:acm:us-west-2:123456789012:certificate/ca") //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		val                 types.Object
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"unknown Object": {
+			val: types.ObjectUnknown(serverCertificateConfigurationModeTestAttrTypes),
+		},
+		"null Object": {
+			val: types.ObjectNull(serverCertificateConfigurationModeTestAttrTypes),
+		},
+		"certificate_authority_arn": {
+			val: serverCertificateConfigurationModeTestValue(certificateAuthorityARN, 0),
+		},
+		"server_certificate": {
+			val: serverCertificateConfigurationModeTestValue(types.StringNull(), 2),
+		},
+		"neither": {
+			val: serverCertificateConfigurationModeTestValue(types.StringNull(), 0),
+		},
+		"both": {
+			val: serverCertificateConfigurationModeTestValue(certificateAuthorityARN, 1),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1),
+					"Invalid Attribute Combination",
+					`Attribute test[1]: "certificate_authority_arn" and "server_certificate" cannot both be specified`,
+				),
+			},
+		},
+		"both unknown certificate_authority_arn": {
+			val: serverCertificateConfigurationModeTestValue(types.StringUnknown(), 1),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			// Validate a later list element to ensure that the check isn't tied to the first one.
+			request := validator.ObjectRequest{
+				Path:           path.Root("test").AtListIndex(1),
+				PathExpression: path.MatchRoot("test").AtListIndex(1),
+				ConfigValue:    testCase.val,
+			}
+			response := validator.ObjectResponse{}
+			serverCertificateConfigurationMode().ValidateObject(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+var (
+	serverCertificateConfigurationModeTestServerCertificateType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		names.AttrResourceARN: types.StringType,
+	}}
+	serverCertificateConfigurationModeTestAttrTypes = map[string]attr.Type{
+		"certificate_authority_arn": types.StringType,
+		"server_certificate":        types.ListType{ElemType: serverCertificateConfigurationModeTestServerCertificateType},
+	}
+)
+
+func serverCertificateConfigurationModeTestValue(certificateAuthorityARN types.String, serverCertificates int) types.Object {
+	var elements []attr.Value
+	for i := range serverCertificates {
+		elements = append(elements, types.ObjectValueMust(serverCertificateConfigurationModeTestServerCertificateType.AttrTypes, map[string]attr.Value{
+			names.AttrResourceARN: types.StringValue(fmt.Sprintf("arn:aws:acm:us-west-2:123456789012:certificate/%d", i)), //lintignore:AWSAT003,AWSAT005
+		}))
+	}
+
+	return types.ObjectValueMust(serverCertificateConfigurationModeTestAttrTypes, map[string]attr.Value{
+		"certificate_authority_arn": certificateAuthorityARN,
+		"server_certificate":        types.ListValueMust(serverCertificateConfigurationModeTestServerCertificateType, elements),
+	})
+}
+
+func TestValidateTCPFlagField(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		flags       []awstypes.TCPFlag
+		masks       []awstypes.TCPFlag
+		expectError bool
+	}{
+		"flags only": {
+			flags: []awstypes.TCPFlag{awstypes.TCPFlagSyn, awstypes.TCPFlagAck},
+		},
+		"flags subset of masks": {
+			flags: []awstypes.TCPFlag{awstypes.TCPFlagSyn},
+			masks: []awstypes.TCPFlag{awstypes.TCPFlagSyn, awstypes.TCPFlagAck},
+		},
+		"flags equal masks": {
+			flags: []awstypes.TCPFlag{awstypes.TCPFlagFin, awstypes.TCPFlagRst, awstypes.TCPFlagPsh, awstypes.TCPFlagUrg, awstypes.TCPFlagEce, awstypes.TCPFlagCwr},
+			masks: []awstypes.TCPFlag{awstypes.TCPFlagFin, awstypes.TCPFlagRst, awstypes.TCPFlagPsh, awstypes.TCPFlagUrg, awstypes.TCPFlagEce, awstypes.TCPFlagCwr},
+		},
+		"flag missing from masks": {
+			flags:       []awstypes.TCPFlag{awstypes.TCPFlagSyn, awstypes.TCPFlagFin},
+			masks:       []awstypes.TCPFlag{awstypes.TCPFlagSyn, awstypes.TCPFlagAck},
+			expectError: true,
+		},
+		"unsupported flag": {
+			flags:       []awstypes.TCPFlag{"NS"},
+			expectError: true,
+		},
+		"unsupported mask": {
+			flags:       []awstypes.TCPFlag{awstypes.TCPFlagSyn},
+			masks:       []awstypes.TCPFlag{awstypes.TCPFlagSyn, "syn"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTCPFlagField(awstypes.TCPFlagField{
+				Flags: testCase.flags,
+				Masks: testCase.masks,
+			})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestValidateRuleGroupCapacity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ruleGroupType awstypes.RuleGroupType
+		capacity      int
+		expectError   bool
+	}{
+		"stateless minimum": {
+			ruleGroupType: awstypes.RuleGroupTypeStateless,
+			capacity:      1,
+		},
+		"stateless maximum": {
+			ruleGroupType: awstypes.RuleGroupTypeStateless,
+			capacity:      30000,
+		},
+		"stateless zero": {
+			ruleGroupType: awstypes.RuleGroupTypeStateless,
+			capacity:      0,
+			expectError:   true,
+		},
+		"stateless above maximum": {
+			ruleGroupType: awstypes.RuleGroupTypeStateless,
+			capacity:      30001,
+			expectError:   true,
+		},
+		"stateful minimum": {
+			ruleGroupType: awstypes.RuleGroupTypeStateful,
+			capacity:      1,
+		},
+		"stateful above stateless maximum": {
+			ruleGroupType: awstypes.RuleGroupTypeStateful,
+			capacity:      30001,
+		},
+		"stateful large": {
+			ruleGroupType: awstypes.RuleGroupTypeStateful,
+			capacity:      2000001,
+		},
+		"stateful negative": {
+			ruleGroupType: awstypes.RuleGroupTypeStateful,
+			capacity:      -1,
+			expectError:   true,
+		},
+		"stateful zero": {
+			ruleGroupType: awstypes.RuleGroupTypeStateful,
+			capacity:      0,
+			expectError:   true,
+		},
+		"unknown type": {
+			ruleGroupType: "UNKNOWN",
+			capacity:      0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRuleGroupCapacity(testCase.ruleGroupType, testCase.capacity)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
@@ -5,12 +5,14 @@ package servicecatalog
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,7 +47,7 @@ func resourceConstraint() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			names.AttrDescription: {
@@ -83,8 +85,60 @@ func resourceConstraint() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(constraintType_Values(), false),
 			},
+			"validate_notification_topics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffAcceptLanguage,
+			customizeDiffLaunchConstraintParameters,
+			customizeDiffNotificationConstraintParameters,
+		),
+	}
+}
+
+func customizeDiffLaunchConstraintParameters(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrType) || !d.NewValueKnown(names.AttrParameters) {
+		return nil
+	}
+
+	if d.Get(names.AttrType).(string) != constraintTypeLaunch {
+		return nil
+	}
+
+	if err := validateLaunchConstraintParameters(d.Get(names.AttrParameters).(string)); err != nil {
+		return fmt.Errorf("%s: %w", names.AttrParameters, err)
+	}
+
+	return nil
+}
+
+func customizeDiffNotificationConstraintParameters(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrType) || !d.NewValueKnown(names.AttrParameters) {
+		return nil
 	}
+
+	if d.Get(names.AttrType).(string) != constraintTypeNotification || !d.Get("validate_notification_topics").(bool) {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(names.AttrParameters, "validate_notification_topics") {
+		return nil
+	}
+
+	topicARNs, err := notificationConstraintTopicARNs(d.Get(names.AttrParameters).(string))
+
+	if err == nil {
+		err = validateNotificationTopics(ctx, meta, topicARNs)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", names.AttrParameters, err)
+	}
+
+	return nil
 }
 
 func resourceConstraintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -165,11 +219,7 @@ func resourceConstraintRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "getting Service Catalog Constraint (%s): empty response", d.Id())
 	}
 
-	acceptLanguage := d.Get("accept_language").(string)
-
-	if acceptLanguage == "" {
-		acceptLanguage = acceptLanguageEnglish
-	}
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
 
 	d.Set("accept_language", acceptLanguage)
 
@@ -191,6 +241,11 @@ func resourceConstraintUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
+	// Changing only validate_notification_topics does not require an update.
+	if !d.HasChangesExcept("validate_notification_topics") {
+		return append(diags, resourceConstraintRead(ctx, d, meta)...)
+	}
+
 	input := &servicecatalog.UpdateConstraintInput{
 		Id: aws.String(d.Id()),
 	}
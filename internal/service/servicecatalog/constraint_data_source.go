@@ -28,7 +28,7 @@ func dataSourceConstraint() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			names.AttrDescription: {
@@ -72,7 +72,8 @@ func dataSourceConstraintRead(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
-	output, err := waitConstraintReady(ctx, conn, d.Get("accept_language").(string), d.Get(names.AttrID).(string), d.Timeout(schema.TimeoutRead))
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
+	output, err := waitConstraintReady(ctx, conn, acceptLanguage, d.Get(names.AttrID).(string), d.Timeout(schema.TimeoutRead))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Constraint: %s", err)
@@ -82,12 +83,6 @@ func dataSourceConstraintRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "getting Service Catalog Constraint: empty response")
 	}
 
-	acceptLanguage := d.Get("accept_language").(string)
-
-	if acceptLanguage == "" {
-		acceptLanguage = acceptLanguageEnglish
-	}
-
 	d.Set("accept_language", acceptLanguage)
 
 	d.Set(names.AttrParameters, output.ConstraintParameters)
@@ -13,6 +13,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -108,6 +109,44 @@ func TestAccServiceCatalogConstraint_update(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogConstraint_product(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_constraint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConstraintDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConstraintConfig_product(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConstraintExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", names.AttrID),
+				),
+			},
+			{
+				Config: testAccConstraintConfig_product(rName, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConstraintExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test2", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckConstraintDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -245,3 +284,37 @@ resource "aws_servicecatalog_constraint" "test" {
 }
 `, rName, description))
 }
+
+func testAccConstraintConfig_product(rName, productResourceName string) string {
+	return acctest.ConfigCompose(testAccConstraintConfig_base(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test2" {
+  name  = "%[1]s-2"
+  owner = "ägare"
+  type  = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    disable_template_validation = true
+    name                        = "%[1]s-2"
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test2" {
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  product_id   = aws_servicecatalog_product.test2.id
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_servicecatalog_constraint" "test" {
+  portfolio_id = aws_servicecatalog_product_portfolio_association.%[2]s.portfolio_id
+  product_id   = aws_servicecatalog_product_portfolio_association.%[2]s.product_id
+  type         = "NOTIFICATION"
+
+  parameters = jsonencode({ "NotificationArns" : [aws_sns_topic.test.arn] })
+}
+`, rName, productResourceName))
+}
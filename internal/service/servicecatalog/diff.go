@@ -4,18 +4,136 @@
 package servicecatalog
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func suppressEquivalentJSONEmptyNilDiffs(k, old, new string, d *schema.ResourceData) bool {
-	if old == "[]" && new == "" {
-		return true
+	return jsonEmptyNilEquivalent(old, new)
+}
+
+// jsonEmptyNilEquivalent returns whether two JSON documents are semantically equivalent once
+// null values, empty arrays and empty objects have been removed at every level.
+// An empty string is equivalent to an empty document.
+func jsonEmptyNilEquivalent(s1, s2 string) bool {
+	v1, err := normalizeJSONEmptyNil(s1)
+	if err != nil {
+		return false
+	}
+
+	v2, err := normalizeJSONEmptyNil(s2)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+func normalizeJSONEmptyNil(s string) (interface{}, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return pruneJSONEmptyNil(v), nil
+}
+
+// pruneJSONEmptyNil recursively removes null values, empty arrays and empty objects.
+// Array elements keep their positions; an element that prunes to empty becomes null.
+func pruneJSONEmptyNil(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if value := pruneJSONEmptyNil(value); value == nil {
+				delete(v, key)
+			} else {
+				v[key] = value
+			}
+		}
+
+		if len(v) == 0 {
+			return nil
+		}
+
+		return v
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+
+		for i, value := range v {
+			v[i] = pruneJSONEmptyNil(value)
+		}
+
+		return v
+	default:
+		return v
 	}
+}
+
+// customizeDiffAcceptLanguage plans the provider-level default accept_language for new resources that do not configure it.
+// Existing resources keep the language they were created with.
+func customizeDiffAcceptLanguage(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("accept_language"); !v.IsNull() {
+		return nil
+	}
+
+	return d.SetNew("accept_language", defaultAcceptLanguage(ctx, meta))
+}
+
+// validateNotificationTopics ensures that each SNS topic ARN is in the provider's Region and that the topic exists.
+// The existence check requires sns:GetTopicAttributes.
+func validateNotificationTopics(ctx context.Context, meta interface{}, topicARNs []string) error {
+	awsClient := meta.(*conns.AWSClient)
+
+	for _, topicARN := range topicARNs {
+		if err := validateNotificationTopicARN(topicARN, awsClient.Region); err != nil {
+			return err
+		}
+
+		_, err := tfsns.FindTopicAttributesByARN(ctx, awsClient.SNSClient(ctx), topicARN)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("notification SNS topic (%s) not found", topicARN)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading notification SNS topic (%s): %w", topicARN, err)
+		}
+	}
+
+	return nil
+}
+
+// acceptLanguageOrDefault returns the configured accept_language or, if unset, the provider-level default.
+func acceptLanguageOrDefault(ctx context.Context, d *schema.ResourceData, meta interface{}) string {
+	if v, ok := d.GetOk("accept_language"); ok {
+		return v.(string)
+	}
+
+	return defaultAcceptLanguage(ctx, meta)
+}
 
-	if old == "" && new == "[]" {
-		return true
+func defaultAcceptLanguage(ctx context.Context, meta interface{}) string {
+	if v := meta.(*conns.AWSClient).ServiceCatalogAcceptLanguage(ctx); v != "" {
+		return v
 	}
 
-	return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
+	return acceptLanguageEnglish
 }
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentJSONEmptyNilDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old  string
+		new  string
+		want bool
+	}{
+		"both empty": {
+			want: true,
+		},
+		"empty string and empty array": {
+			old:  "[]",
+			new:  "",
+			want: true,
+		},
+		"empty string and null": {
+			old:  "",
+			new:  "null",
+			want: true,
+		},
+		"empty array and empty object with whitespace": {
+			old:  " [ ] ",
+			new:  "{}",
+			want: true,
+		},
+		"key ordering": {
+			old:  `[{"Name":"InstanceId","Type":"TARGET"}]`,
+			new:  `[{"Type":"TARGET","Name":"InstanceId"}]`,
+			want: true,
+		},
+		"nested key ordering": {
+			old:  `[{"Name":"Config","Type":"TEXT_VALUE","DefaultValue":{"a":{"b":[1,{"c":"d","e":"f"}]},"g":"h"}}]`,
+			new:  `[{"DefaultValue":{"g":"h","a":{"b":[1,{"e":"f","c":"d"}]}},"Type":"TEXT_VALUE","Name":"Config"}]`,
+			want: true,
+		},
+		"null field": {
+			old:  `[{"Name":"InstanceId","Type":"TARGET","DefaultValue":null}]`,
+			new:  `[{"Name":"InstanceId","Type":"TARGET"}]`,
+			want: true,
+		},
+		"nested empty fields": {
+			old:  `[{"Name":"Config","Type":"TEXT_VALUE","DefaultValue":{"a":{"b":[],"c":{}},"d":"e"}}]`,
+			new:  `[{"Name":"Config","Type":"TEXT_VALUE","DefaultValue":{"d":"e","a":{"c":null}}}]`,
+			want: true,
+		},
+		"empty string value": {
+			old:  `[{"Name":"InstanceId","Type":"TARGET","DefaultValue":""}]`,
+			new:  `[{"Name":"InstanceId","Type":"TARGET"}]`,
+			want: false,
+		},
+		"different value": {
+			old:  `[{"Name":"InstanceId","Type":"TARGET"}]`,
+			new:  `[{"Name":"InstanceId","Type":"TEXT_VALUE"}]`,
+			want: false,
+		},
+		"different nested value": {
+			old:  `[{"Name":"Config","Type":"TEXT_VALUE","DefaultValue":{"a":{"b":[1,2]}}}]`,
+			new:  `[{"Name":"Config","Type":"TEXT_VALUE","DefaultValue":{"a":{"b":[2,1]}}}]`,
+			want: false,
+		},
+		"array element ordering": {
+			old:  `[{"Name":"InstanceId","Type":"TARGET"},{"Name":"Force","Type":"TEXT_VALUE"}]`,
+			new:  `[{"Name":"Force","Type":"TEXT_VALUE"},{"Name":"InstanceId","Type":"TARGET"}]`,
+			want: false,
+		},
+		"empty and non-empty": {
+			old:  "",
+			new:  `[{"Name":"InstanceId","Type":"TARGET"}]`,
+			want: false,
+		},
+		"invalid JSON": {
+			old:  `[{"Name":"InstanceId","Type":"TARGET"}]`,
+			new:  `[{"Name":"InstanceId","Type":"TARGET"}`,
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := suppressEquivalentJSONEmptyNilDiffs("definition.0.parameters", testCase.old, testCase.new, nil); got != testCase.want {
+				t.Errorf("suppressEquivalentJSONEmptyNilDiffs(%q, %q) = %t, want %t", testCase.old, testCase.new, got, testCase.want)
+			}
+
+			if got := suppressEquivalentJSONEmptyNilDiffs("definition.0.parameters", testCase.new, testCase.old, nil); got != testCase.want {
+				t.Errorf("suppressEquivalentJSONEmptyNilDiffs(%q, %q) = %t, want %t", testCase.new, testCase.old, got, testCase.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,12 @@ const (
 	constraintTypeResourceUpdate = "RESOURCE_UPDATE"
 	constraintTypeStackset       = "STACKSET"
 	constraintTypeTemplate       = "TEMPLATE"
+
+	// serviceActionAssumeRoleLaunch is the special AssumeRole value that reuses the provisioned product launch role.
+	serviceActionAssumeRoleLaunch = "LAUNCH_ROLE"
+
+	serviceActionParameterTypeTarget    = "TARGET"
+	serviceActionParameterTypeTextValue = "TEXT_VALUE"
 )
 
 func acceptLanguage_Values() []string {
@@ -34,3 +40,10 @@ func constraintType_Values() []string {
 		constraintTypeTemplate,
 	}
 }
+
+func serviceActionParameterType_Values() []string {
+	return []string{
+		serviceActionParameterTypeTarget,
+		serviceActionParameterTypeTextValue,
+	}
+}
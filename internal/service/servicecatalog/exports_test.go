@@ -15,21 +15,36 @@ var (
 	ResourceProvisioningArtifact          = resourceProvisioningArtifact
 	ResourcePrincipalPortfolioAssociation = resourcePrincipalPortfolioAssociation
 	ResourceServiceAction                 = resourceServiceAction
+	ResourceServiceActionAssociation      = resourceServiceActionAssociation
 	ResourceTagOption                     = resourceTagOption
 	ResourceTagOptionResourceAssociation  = resourceTagOptionResourceAssociation
 
-	FindPortfolioByID                 = findPortfolioByID
-	FindPortfolioShare                = findPortfolioShare
-	FindPrincipalPortfolioAssociation = findPrincipalPortfolioAssociation
+	FindPortfolioByID                         = findPortfolioByID
+	FindPortfolioShare                        = findPortfolioShare
+	FindPrincipalPortfolioAssociation         = findPrincipalPortfolioAssociation
+	FindProvisioningArtifactsForServiceAction = findProvisioningArtifactsForServiceAction
+	FindServiceActionAssociation              = findServiceActionAssociation
+	FindServiceActionByID                     = findServiceActionByID
+	IgnoreRecordErrors                        = ignoreRecordErrors
 
 	BudgetResourceAssociationParseID             = budgetResourceAssociationParseID
 	ProductPortfolioAssociationParseID           = productPortfolioAssociationParseID
 	ProvisioningArtifactParseID                  = provisioningArtifactParseID
 	PrincipalPortfolioAssociationParseResourceID = principalPortfolioAssociationParseResourceID
+	ServiceActionAssociationParseImportID        = serviceActionAssociationParseImportID
+	ServiceActionAssociationParseResourceID      = serviceActionAssociationParseResourceID
 	TagOptionResourceAssociationParseID          = tagOptionResourceAssociationParseID
 
+	ExpandServiceActionDefinition  = expandServiceActionDefinition
+	FlattenServiceActionDefinition = flattenServiceActionDefinition
+	UpdateProvisionedProduct       = updateProvisionedProduct
+	UpdateServiceAction            = updateServiceAction
+
 	AcceptLanguageEnglish = acceptLanguageEnglish
 	StatusCreated         = statusCreated
+	StatusNotFound        = statusNotFound
+
+	StatusProvisioningArtifact = statusProvisioningArtifact
 
 	WaitBudgetResourceAssociationDeleted    = waitBudgetResourceAssociationDeleted
 	WaitBudgetResourceAssociationReady      = waitBudgetResourceAssociationReady
@@ -37,6 +52,8 @@ var (
 	WaitProductPortfolioAssociationDeleted  = waitProductPortfolioAssociationDeleted
 	WaitProductPortfolioAssociationReady    = waitProductPortfolioAssociationReady
 	WaitProvisionedProductReady             = waitProvisionedProductReady
+	WaitProvisionedProductTerminated        = waitProvisionedProductTerminated
+	WaitProvisioningArtifactReady           = waitProvisioningArtifactReady
 	WaitTagOptionResourceAssociationDeleted = waitTagOptionResourceAssociationDeleted
 	WaitTagOptionResourceAssociationReady   = waitTagOptionResourceAssociationReady
 )
@@ -146,6 +146,27 @@ func findBudgetResourceAssociations(ctx context.Context, conn *servicecatalog.Cl
 	return result, nil
 }
 
+func findTagOptions(ctx context.Context, conn *servicecatalog.Client, filters *awstypes.ListTagOptionsFilters) ([]awstypes.TagOptionDetail, error) {
+	input := &servicecatalog.ListTagOptionsInput{
+		Filters: filters,
+	}
+
+	var result []awstypes.TagOptionDetail
+
+	pages := servicecatalog.NewListTagOptionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.TagOptionDetails...)
+	}
+
+	return result, nil
+}
+
 func findTagOptionResourceAssociation(ctx context.Context, conn *servicecatalog.Client, tagOptionID, resourceID string) (*awstypes.ResourceDetail, error) {
 	output, err := findTagOptionResourceAssociations(ctx, conn, tagOptionID, resourceID)
 
@@ -208,3 +229,338 @@ func findProductByID(ctx context.Context, conn *servicecatalog.Client, productID
 
 	return out, nil
 }
+
+// findProductsByName returns the products whose name exactly matches name.
+// SearchProductsAsAdmin only supports full-text search, so its results are filtered client-side.
+func findProductsByName(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, name string) ([]awstypes.ProductViewDetail, error) {
+	input := &servicecatalog.SearchProductsAsAdminInput{
+		Filters: map[string][]string{
+			string(awstypes.ProductViewFilterByFullTextSearch): {name},
+		},
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result []awstypes.ProductViewDetail
+
+	pages := servicecatalog.NewSearchProductsAsAdminPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ProductViewDetails {
+			if v.ProductViewSummary != nil && aws.ToString(v.ProductViewSummary.Name) == name {
+				result = append(result, v)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func findProvisionedProductsByName(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, name string) ([]awstypes.ProvisionedProductAttribute, error) {
+	input := &servicecatalog.SearchProvisionedProductsInput{
+		AccessLevelFilter: &awstypes.AccessLevelFilter{
+			Key:   awstypes.AccessLevelFilterKeyAccount,
+			Value: aws.String("self"),
+		},
+		Filters: map[string][]string{
+			string(awstypes.ProvisionedProductViewFilterBySearchQuery): {"name:" + name},
+		},
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result []awstypes.ProvisionedProductAttribute
+
+	pages := servicecatalog.NewSearchProvisionedProductsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ProvisionedProducts {
+			if aws.ToString(v.Name) == name {
+				result = append(result, v)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func findServiceActions(ctx context.Context, conn *servicecatalog.Client, acceptLanguage string) ([]awstypes.ServiceActionSummary, error) {
+	input := &servicecatalog.ListServiceActionsInput{}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result []awstypes.ServiceActionSummary
+
+	pages := servicecatalog.NewListServiceActionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.ServiceActionSummaries...)
+	}
+
+	return result, nil
+}
+
+func findServiceActionByID(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id string) (*awstypes.ServiceActionDetail, error) {
+	input := &servicecatalog.DescribeServiceActionInput{
+		Id: aws.String(id),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	output, err := conn.DescribeServiceAction(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceActionDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceActionDetail, nil
+}
+
+func findProvisionedProductPlanByID(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string) (*servicecatalog.DescribeProvisionedProductPlanOutput, error) {
+	input := &servicecatalog.DescribeProvisionedProductPlanInput{
+		PlanId: aws.String(planID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result *servicecatalog.DescribeProvisionedProductPlanOutput
+
+	// There is no paginator for DescribeProvisionedProductPlan, so page through the resource changes manually.
+	for {
+		output, err := conn.DescribeProvisionedProductPlan(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.ProvisionedProductPlanDetails == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if result == nil {
+			result = output
+		} else {
+			result.ResourceChanges = append(result.ResourceChanges, output.ResourceChanges...)
+		}
+
+		if aws.ToString(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	result.NextPageToken = nil
+
+	return result, nil
+}
+
+func findPortfoliosForProduct(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, productID string) ([]awstypes.PortfolioDetail, error) {
+	input := &servicecatalog.ListPortfoliosForProductInput{
+		ProductId: aws.String(productID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result []awstypes.PortfolioDetail
+
+	pages := servicecatalog.NewListPortfoliosForProductPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.PortfolioDetails...)
+	}
+
+	return result, nil
+}
+
+func findConstraintsForPortfolio(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, portfolioID, productID string) ([]awstypes.ConstraintDetail, error) {
+	input := &servicecatalog.ListConstraintsForPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	if productID != "" {
+		input.ProductId = aws.String(productID)
+	}
+
+	var result []awstypes.ConstraintDetail
+
+	pages := servicecatalog.NewListConstraintsForPortfolioPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.ConstraintDetails...)
+	}
+
+	return result, nil
+}
+
+// findProductConstraints returns the constraints applied to a product in every portfolio the product belongs to.
+// A constraint that is listed via more than one portfolio is only returned once.
+func findProductConstraints(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, productID string) ([]awstypes.ConstraintDetail, error) {
+	portfolios, err := findPortfoliosForProduct(ctx, conn, acceptLanguage, productID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result []awstypes.ConstraintDetail
+	seen := make(map[string]struct{})
+
+	for _, portfolio := range portfolios {
+		constraints, err := findConstraintsForPortfolio(ctx, conn, acceptLanguage, aws.ToString(portfolio.Id), productID)
+
+		// The product may have been disassociated from the portfolio since it was listed.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, constraint := range constraints {
+			id := aws.ToString(constraint.ConstraintId)
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			result = append(result, constraint)
+		}
+	}
+
+	return result, nil
+}
+
+func findProvisioningArtifactsForServiceAction(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, serviceActionID string) ([]awstypes.ProvisioningArtifactView, error) {
+	input := &servicecatalog.ListProvisioningArtifactsForServiceActionInput{
+		ServiceActionId: aws.String(serviceActionID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	var result []awstypes.ProvisioningArtifactView
+
+	pages := servicecatalog.NewListProvisioningArtifactsForServiceActionPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.ProvisioningArtifactViews...)
+	}
+
+	return result, nil
+}
+
+func findRecordByID(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id string) (*servicecatalog.DescribeRecordOutput, error) {
+	input := &servicecatalog.DescribeRecordInput{
+		Id: aws.String(id),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	output, err := conn.DescribeRecord(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RecordDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -80,3 +80,7 @@ func provisioningArtifactParseID(id string) (string, string, error) {
 func portfolioConstraintsID(acceptLanguage, portfolioID, productID string) string {
 	return strings.Join([]string{acceptLanguage, portfolioID, productID}, ":")
 }
+
+func productConstraintsID(acceptLanguage, productID string) string {
+	return strings.Join([]string{acceptLanguage, productID}, ":")
+}
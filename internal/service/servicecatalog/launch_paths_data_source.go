@@ -30,7 +30,7 @@ func dataSourceLaunchPaths() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"product_id": {
@@ -79,12 +79,14 @@ func dataSourceLaunchPathsRead(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	summaries, err := waitLaunchPathsReady(ctx, conn, d.Get("accept_language").(string), d.Get("product_id").(string), d.Timeout(schema.TimeoutRead))
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
+	summaries, err := waitLaunchPathsReady(ctx, conn, acceptLanguage, d.Get("product_id").(string), d.Timeout(schema.TimeoutRead))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Launch Paths: %s", err)
 	}
 
+	d.Set("accept_language", acceptLanguage)
 	if err := d.Set("summaries", flattenLaunchPathSummaries(ctx, summaries, ignoreTagsConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting summaries: %s", err)
 	}
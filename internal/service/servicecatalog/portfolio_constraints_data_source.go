@@ -29,7 +29,7 @@ func dataSourcePortfolioConstraints() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"details": {
@@ -80,7 +80,8 @@ func dataSourcePortfolioConstraintsRead(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
-	output, err := waitPortfolioConstraintsReady(ctx, conn, d.Get("accept_language").(string), d.Get("portfolio_id").(string), d.Get("product_id").(string), d.Timeout(schema.TimeoutRead))
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
+	output, err := waitPortfolioConstraintsReady(ctx, conn, acceptLanguage, d.Get("portfolio_id").(string), d.Get("product_id").(string), d.Timeout(schema.TimeoutRead))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Portfolio Constraints: %s", err)
@@ -90,12 +91,6 @@ func dataSourcePortfolioConstraintsRead(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "getting Service Catalog Portfolio Constraints: no results, change your input")
 	}
 
-	acceptLanguage := d.Get("accept_language").(string)
-
-	if acceptLanguage == "" {
-		acceptLanguage = acceptLanguageEnglish
-	}
-
 	d.Set("accept_language", acceptLanguage)
 	d.Set("portfolio_id", d.Get("portfolio_id").(string))
 	d.Set("product_id", d.Get("product_id").(string))
@@ -104,7 +99,7 @@ func dataSourcePortfolioConstraintsRead(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "setting details: %s", err)
 	}
 
-	d.SetId(portfolioConstraintsID(d.Get("accept_language").(string), d.Get("portfolio_id").(string), d.Get("product_id").(string)))
+	d.SetId(portfolioConstraintsID(acceptLanguage, d.Get("portfolio_id").(string), d.Get("product_id").(string)))
 
 	return diags
 }
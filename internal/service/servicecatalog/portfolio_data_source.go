@@ -33,7 +33,7 @@ func dataSourcePortfolio() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			names.AttrARN: {
@@ -69,12 +69,10 @@ func dataSourcePortfolioRead(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
 	input := &servicecatalog.DescribePortfolioInput{
-		Id: aws.String(d.Get(names.AttrID).(string)),
-	}
-
-	if v, ok := d.GetOk("accept_language"); ok {
-		input.AcceptLanguage = aws.String(v.(string))
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(d.Get(names.AttrID).(string)),
 	}
 
 	output, err := conn.DescribePortfolio(ctx, input)
@@ -95,6 +93,7 @@ func dataSourcePortfolioRead(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[DEBUG] Error setting created_time: %s", err)
 	}
 
+	d.Set("accept_language", acceptLanguage)
 	d.Set(names.AttrARN, detail.ARN)
 	d.Set(names.AttrDescription, detail.Description)
 	d.Set(names.AttrName, detail.DisplayName)
@@ -46,7 +46,7 @@ func resourcePortfolioShare() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"accepted": {
@@ -99,6 +99,8 @@ func resourcePortfolioShare() *schema.Resource {
 				Default:  false,
 			},
 		},
+
+		CustomizeDiff: customizeDiffAcceptLanguage,
 	}
 }
 
@@ -56,8 +56,8 @@ func resourcePrincipalPortfolioAssociation() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
-				Default:      acceptLanguageEnglish,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"portfolio_id": {
@@ -78,6 +78,8 @@ func resourcePrincipalPortfolioAssociation() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.PrincipalType](),
 			},
 		},
+
+		CustomizeDiff: customizeDiffAcceptLanguage,
 	}
 }
 
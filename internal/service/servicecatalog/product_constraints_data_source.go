@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_servicecatalog_product_constraints", name="Product Constraints")
+func dataSourceProductConstraints() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProductConstraintsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
+			},
+			"details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"constraint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrOwner: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"portfolio_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceProductConstraintsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
+	productID := d.Get("product_id").(string)
+	output, err := findProductConstraints(ctx, conn, acceptLanguage, productID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Service Catalog Product (%s) Constraints: %s", productID, err)
+	}
+
+	d.SetId(productConstraintsID(acceptLanguage, productID))
+	d.Set("accept_language", acceptLanguage)
+	if err := d.Set("details", flattenConstraintDetails(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting details: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogProductConstraintsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName1 := "aws_servicecatalog_constraint.test"
+	resourceName2 := "aws_servicecatalog_constraint.test2"
+	dataSourceName := "data.aws_servicecatalog_product_constraints.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductConstraintsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accept_language", "en"),
+					resource.TestCheckResourceAttr(dataSourceName, "details.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "details.*.constraint_id", resourceName1, names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "details.*.constraint_id", resourceName2, names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "details.*.portfolio_id", resourceName1, "portfolio_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "details.*.portfolio_id", resourceName2, "portfolio_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "details.0.product_id", resourceName1, "product_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "details.1.product_id", resourceName1, "product_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "product_id", resourceName1, "product_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProductConstraintsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConstraintConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test2" {
+  name          = "%[1]s-2"
+  provider_name = %[1]q
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test2" {
+  portfolio_id = aws_servicecatalog_portfolio.test2.id
+  product_id   = aws_servicecatalog_product.test.id
+}
+
+resource "aws_servicecatalog_constraint" "test2" {
+  description  = "%[1]s-2"
+  portfolio_id = aws_servicecatalog_product_portfolio_association.test2.portfolio_id
+  product_id   = aws_servicecatalog_product_portfolio_association.test2.product_id
+  type         = "NOTIFICATION"
+
+  parameters = jsonencode({ "NotificationArns" : [aws_sns_topic.test.arn] })
+}
+
+data "aws_servicecatalog_product_constraints" "test" {
+  product_id = aws_servicecatalog_product.test.id
+
+  depends_on = [aws_servicecatalog_constraint.test, aws_servicecatalog_constraint.test2]
+}
+`, rName))
+}
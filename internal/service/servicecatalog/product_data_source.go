@@ -35,7 +35,7 @@ func dataSourceProduct() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			names.AttrCreatedTime: {
@@ -55,12 +55,16 @@ func dataSourceProduct() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrID: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrID, names.AttrName},
 			},
 			names.AttrName: {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrID, names.AttrName},
 			},
 			names.AttrOwner: {
 				Type:     schema.TypeString,
@@ -95,7 +99,28 @@ func dataSourceProductRead(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
-	output, err := waitProductReady(ctx, conn, d.Get("accept_language").(string), d.Get(names.AttrID).(string), d.Timeout(schema.TimeoutRead))
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
+	productID := d.Get(names.AttrID).(string)
+
+	if v, ok := d.GetOk(names.AttrName); ok && productID == "" {
+		name := v.(string)
+		products, err := findProductsByName(ctx, conn, acceptLanguage, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "searching Service Catalog Products: %s", err)
+		}
+
+		switch n := len(products); n {
+		case 0:
+			return sdkdiag.AppendErrorf(diags, "no Service Catalog Product found with name (%s)", name)
+		case 1:
+			productID = aws.ToString(products[0].ProductViewSummary.ProductId)
+		default:
+			return sdkdiag.AppendErrorf(diags, "%d Service Catalog Products found with name (%s), use id to select one", n, name)
+		}
+	}
+
+	output, err := waitProductReady(ctx, conn, acceptLanguage, productID, d.Timeout(schema.TimeoutRead))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Product: %s", err)
@@ -107,6 +132,7 @@ func dataSourceProductRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	pvs := output.ProductViewDetail.ProductViewSummary
 
+	d.Set("accept_language", acceptLanguage)
 	d.Set(names.AttrARN, output.ProductViewDetail.ProductARN)
 	if output.ProductViewDetail.CreatedTime != nil {
 		d.Set(names.AttrCreatedTime, output.ProductViewDetail.CreatedTime.Format(time.RFC3339))
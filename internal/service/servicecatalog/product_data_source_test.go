@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -92,6 +93,54 @@ func TestAccServiceCatalogProductDataSource_physicalID(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProductDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_product.test"
+	dataSourceName := "data.aws_servicecatalog_product.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductDataSourceConfig_name(rName, "beskrivning", "supportbeskrivning", domain, acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, dataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, dataSourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrOwner, dataSourceName, names.AttrOwner),
+					resource.TestCheckResourceAttrPair(resourceName, "support_description", dataSourceName, "support_description"),
+					resource.TestCheckResourceAttrPair(resourceName, "support_email", dataSourceName, "support_email"),
+					resource.TestCheckResourceAttrPair(resourceName, "support_url", dataSourceName, "support_url"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrType, dataSourceName, names.AttrType),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProductDataSource_nameNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProductDataSourceConfig_nameNotFound(rName),
+				ExpectError: regexache.MustCompile(`no Service Catalog Product found with name`),
+			},
+		},
+	})
+}
+
 func testAccProductDataSourceConfig_basic(rName, description, supportDescription, domain, email string) string {
 	return acctest.ConfigCompose(testAccProductConfig_basic(rName, description, supportDescription, domain, email), `
 data "aws_servicecatalog_product" "test" {
@@ -107,3 +156,19 @@ data "aws_servicecatalog_product" "test" {
 }
 `)
 }
+
+func testAccProductDataSourceConfig_name(rName, description, supportDescription, domain, email string) string {
+	return acctest.ConfigCompose(testAccProductConfig_basic(rName, description, supportDescription, domain, email), `
+data "aws_servicecatalog_product" "test" {
+  name = aws_servicecatalog_product.test.name
+}
+`)
+}
+
+func testAccProductDataSourceConfig_nameNotFound(rName string) string {
+	return fmt.Sprintf(`
+data "aws_servicecatalog_product" "test" {
+  name = %[1]q
+}
+`, rName)
+}
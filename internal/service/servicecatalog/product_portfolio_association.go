@@ -40,8 +40,8 @@ func resourceProductPortfolioAssociation() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
-				Default:      acceptLanguageEnglish,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"portfolio_id": {
@@ -60,6 +60,8 @@ func resourceProductPortfolioAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffAcceptLanguage,
 	}
 }
 
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -75,6 +77,75 @@ func TestAccServiceCatalogProductPortfolioAssociation_disappears(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProductPortfolioAssociation_disappears_Portfolio(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_product_portfolio_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProductPortfolioAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductPortfolioAssociationConfig_basic(rName, domain, acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProductPortfolioAssociationExists(ctx, resourceName),
+					testAccCheckProductPortfolioAssociationDeletePortfolio(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckProductPortfolioAssociationDeletePortfolio deletes the association's portfolio outside Terraform,
+// disassociating the product first as Service Catalog requires, so that the association is next read with its portfolio gone.
+func testAccCheckProductPortfolioAssociationDeletePortfolio(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		acceptLanguage, portfolioID, productID, err := tfservicecatalog.ProductPortfolioAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("could not parse ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+		_, err = conn.DisassociateProductFromPortfolio(ctx, &servicecatalog.DisassociateProductFromPortfolioInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			PortfolioId:    aws.String(portfolioID),
+			ProductId:      aws.String(productID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("disassociating Service Catalog Product (%s) from Portfolio (%s): %w", productID, portfolioID, err)
+		}
+
+		if err := tfservicecatalog.WaitProductPortfolioAssociationDeleted(ctx, conn, acceptLanguage, portfolioID, productID, tfservicecatalog.ProductPortfolioAssociationDeleteTimeout); err != nil {
+			return fmt.Errorf("waiting for Service Catalog Product (%s) to be disassociated from Portfolio (%s): %w", productID, portfolioID, err)
+		}
+
+		_, err = conn.DeletePortfolio(ctx, &servicecatalog.DeletePortfolioInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			Id:             aws.String(portfolioID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting Service Catalog Portfolio (%s): %w", portfolioID, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckProductPortfolioAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
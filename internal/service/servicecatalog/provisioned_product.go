@@ -8,11 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -20,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -29,9 +33,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var provisionedProductIDRegexp = regexache.MustCompile(`^pp-[0-9a-z]+$`)
+
 // @SDKResource("aws_servicecatalog_provisioned_product", name="Provisioned Product")
 // @Tags
-// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/servicecatalog/types;types.ProvisionedProductDetail",importIgnore="accept_language;ignore_errors;provisioning_artifact_name;provisioning_parameters;retain_physical_resources", skipEmptyTags=true, noRemoveTags=true)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/servicecatalog/types;types.ProvisionedProductDetail",importIgnore="accept_language;ignore_errors;provisioning_artifact_name;provisioning_parameters;retain_physical_resources;skip_destroy", skipEmptyTags=true, noRemoveTags=true)
 func resourceProvisionedProduct() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisionedProductCreate,
@@ -40,7 +46,7 @@ func resourceProvisionedProduct() *schema.Resource {
 		DeleteWithoutTimeout: resourceProvisionedProductDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceProvisionedProductImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -54,7 +60,7 @@ func resourceProvisionedProduct() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			names.AttrARN: {
@@ -75,6 +81,11 @@ func resourceProvisionedProduct() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"ignore_update_error_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"last_provisioning_record_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -191,11 +202,25 @@ func resourceProvisionedProduct() *schema.Resource {
 					},
 				},
 			},
+			"record_outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"recover_tainted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"retain_physical_resources": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			names.AttrSkipDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"stack_set_provisioning_preferences": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -239,6 +264,11 @@ func resourceProvisionedProduct() *schema.Resource {
 								"stack_set_provisioning_preferences.0.max_concurrency_percentage",
 							},
 						},
+						"operation_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.StackSetOperationType](),
+						},
 						"regions": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -261,20 +291,71 @@ func resourceProvisionedProduct() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"validate_notification_topics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
+			customizeDiffAcceptLanguage,
+			customizeDiffNotificationARNs,
+			customizeDiffRecoverTainted,
 			refreshOutputsDiff,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+func customizeDiffNotificationARNs(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_notification_topics").(bool) {
+		return nil
+	}
+
+	if !diff.GetRawPlan().GetAttr("notification_arns").IsWhollyKnown() {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("notification_arns", "validate_notification_topics") {
+		return nil
+	}
+
+	topicARNs := flex.ExpandStringValueList(diff.Get("notification_arns").([]interface{}))
+
+	if err := validateNotificationTopics(ctx, meta, topicARNs); err != nil {
+		return fmt.Errorf("notification_arns: %w", err)
+	}
+
+	return nil
+}
+
+// customizeDiffRecoverTainted plans an update of a TAINTED provisioned product when recover_tainted is set,
+// so that the configuration is applied again even if it has not changed.
+func customizeDiffRecoverTainted(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("recover_tainted").(bool) {
+		return nil
+	}
+
+	if status, _ := diff.GetChange(names.AttrStatus); status.(string) != string(awstypes.ProvisionedProductStatusTainted) {
+		return nil
+	}
+
+	if err := diff.SetNewComputed(names.AttrStatus); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed(names.AttrStatusMessage)
+}
+
 func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if diff.HasChanges("provisioning_parameters", "provisioning_artifact_id", "provisioning_artifact_name") {
 		if err := diff.SetNewComputed("outputs"); err != nil {
 			return err
 		}
+
+		if err := diff.SetNewComputed("record_outputs"); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -377,6 +458,96 @@ func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceDat
 	return append(diags, resourceProvisionedProductRead(ctx, d, meta)...)
 }
 
+// resourceProvisionedProductImport accepts either a provisioned product ID or, e.g. for products
+// provisioned outside Terraform, a provisioned product name.
+func resourceProvisionedProductImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
+
+	if !provisionedProductIDRegexp.MatchString(d.Id()) {
+		name := d.Id()
+		products, err := findProvisionedProductsByName(ctx, conn, acceptLanguage, name)
+
+		if err != nil {
+			return nil, fmt.Errorf("searching Service Catalog Provisioned Products: %w", err)
+		}
+
+		switch n := len(products); n {
+		case 0:
+			return nil, fmt.Errorf("no Service Catalog Provisioned Product found with name (%s)", name)
+		case 1:
+			d.SetId(aws.ToString(products[0].Id))
+		default:
+			return nil, fmt.Errorf("%d Service Catalog Provisioned Products found with name (%s), import by ID instead", n, name)
+		}
+	}
+
+	// Parameter values are not returned by the API, so import the provisioning artifact's parameter keys
+	// as use_previous_value markers. This is best effort: the keys cannot be listed for every launch path.
+	if v, err := findProvisionedProductParameterKeys(ctx, conn, acceptLanguage, d.Id()); err != nil {
+		log.Printf("[WARN] Unable to import Service Catalog Provisioned Product (%s) parameters: %s", d.Id(), err)
+	} else {
+		tfList := make([]interface{}, 0, len(v))
+		for _, key := range v {
+			tfList = append(tfList, map[string]interface{}{
+				names.AttrKey:        key,
+				"use_previous_value": true,
+			})
+		}
+		d.Set("provisioning_parameters", tfList)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findProvisionedProductParameterKeys returns the parameter keys of the provisioned product's provisioning artifact.
+func findProvisionedProductParameterKeys(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, id string) ([]string, error) {
+	input := &servicecatalog.DescribeProvisionedProductInput{
+		Id: aws.String(id),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	output, err := conn.DescribeProvisionedProduct(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProvisionedProductDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	detail := output.ProvisionedProductDetail
+	parametersInput := &servicecatalog.DescribeProvisioningParametersInput{
+		ProductId:              detail.ProductId,
+		ProvisioningArtifactId: detail.ProvisioningArtifactId,
+	}
+
+	if acceptLanguage != "" {
+		parametersInput.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	parametersOutput, err := conn.DescribeProvisioningParameters(ctx, parametersInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if parametersOutput == nil {
+		return nil, tfresource.NewEmptyResultError(parametersInput)
+	}
+
+	var keys []string
+	for _, v := range parametersOutput.ProvisioningArtifactParameters {
+		keys = append(keys, aws.ToString(v.ParameterKey))
+	}
+
+	return keys, nil
+}
+
 func resourceProvisionedProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -388,11 +559,7 @@ func resourceProvisionedProductRead(ctx context.Context, d *schema.ResourceData,
 	// They provide some overlapping information. Most of the unique information available from
 	// DescribeRecord is available in the data source aws_servicecatalog_record.
 
-	acceptLanguage := acceptLanguageEnglish
-
-	if v, ok := d.GetOk("accept_language"); ok {
-		acceptLanguage = v.(string)
-	}
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
 
 	input := &servicecatalog.DescribeProvisionedProductInput{
 		Id:             aws.String(d.Id()),
@@ -479,6 +646,28 @@ func resourceProvisionedProductRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "setting outputs: %s", err)
 	}
 
+	// Record outputs are taken from the last successful provisioning record, which differs from
+	// the last provisioning record when the most recent update failed.
+	successfulRecordOutput := recordOutput
+	if v := detail.LastSuccessfulProvisioningRecordId; v != nil && aws.ToString(v) != aws.ToString(detail.LastProvisioningRecordId) {
+		input := &servicecatalog.DescribeRecordInput{
+			Id:             v,
+			AcceptLanguage: aws.String(acceptLanguage),
+		}
+
+		successfulRecordOutput, err = conn.DescribeRecord(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "describing Service Catalog Provisioned Product (%s) Record (%s): %s", d.Id(), aws.ToString(v), err)
+		}
+	}
+
+	if detail.LastSuccessfulProvisioningRecordId == nil {
+		d.Set("record_outputs", nil)
+	} else {
+		d.Set("record_outputs", flattenRecordOutputValues(successfulRecordOutput.RecordOutputs))
+	}
+
 	d.Set("path_id", recordOutput.RecordDetail.PathId)
 
 	setTagsOut(ctx, Tags(recordKeyValueTags(ctx, recordOutput.RecordDetail.RecordTags)))
@@ -490,6 +679,16 @@ func resourceProvisionedProductUpdate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
+	// Changing only these arguments does not require an update.
+	if !d.HasChangesExcept("ignore_update_error_codes", "recover_tainted", "validate_notification_topics") {
+		return append(diags, resourceProvisionedProductRead(ctx, d, meta)...)
+	}
+
+	if status, _ := d.GetChange(names.AttrStatus); status.(string) == string(awstypes.ProvisionedProductStatusTainted) && d.Get("recover_tainted").(bool) {
+		statusMessage, _ := d.GetChange(names.AttrStatusMessage)
+		log.Printf("[WARN] Service Catalog Provisioned Product (%s) is %s, updating to recover: %s", d.Id(), status, statusMessage)
+	}
+
 	input := &servicecatalog.UpdateProvisionedProductInput{
 		UpdateToken:          aws.String(id.UniqueId()),
 		ProvisionedProductId: aws.String(d.Id()),
@@ -534,10 +733,34 @@ func resourceProvisionedProductUpdate(ctx context.Context, d *schema.ResourceDat
 	// to provisioned AWS objects during update if the tags don't change.
 	input.Tags = getTagsIn(ctx)
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	if err := updateProvisionedProduct(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+	}
+
+	if output, err := waitProvisionedProductReady(ctx, conn, d.Get("accept_language").(string), d.Id(), "", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if v, ok := d.GetOk("ignore_update_error_codes"); ok && v.(*schema.Set).Len() > 0 && output != nil && output.ProvisionedProductDetail != nil {
+			recordID := aws.ToString(output.ProvisionedProductDetail.LastProvisioningRecordId)
+			if ignoreErr := ignoreRecordErrors(ctx, conn, d.Get("accept_language").(string), recordID, flex.ExpandStringValueSet(v.(*schema.Set))); ignoreErr == nil {
+				log.Printf("[WARN] Ignoring Service Catalog Provisioned Product (%s) update error: %s", d.Id(), err)
+				err = nil
+			}
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Provisioned Product (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProvisionedProductRead(ctx, d, meta)...)
+}
+
+// updateProvisionedProduct retries transient errors with backoff until the timeout expires.
+// Concurrent updates of many provisioned products of the same product can be throttled or rejected.
+func updateProvisionedProduct(ctx context.Context, conn *servicecatalog.Client, input *servicecatalog.UpdateProvisionedProductInput, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		_, err := conn.UpdateProvisionedProduct(ctx, input)
 
-		if errs.IsAErrorMessageContains[*awstypes.InvalidParametersException](err, "profile does not exist") {
+		if isProvisionedProductUpdateRetryableError(err) {
 			return retry.RetryableError(err)
 		}
 
@@ -552,19 +775,55 @@ func resourceProvisionedProductUpdate(ctx context.Context, d *schema.ResourceDat
 		_, err = conn.UpdateProvisionedProduct(ctx, input)
 	}
 
+	return err
+}
+
+// isProvisionedProductUpdateRetryableError returns whether an update error is transient.
+// Only an InvalidStateException for a provisioned product with another operation in progress is retried;
+// other states, e.g. ERROR or TAINTED, won't change by retrying.
+func isProvisionedProductUpdateRetryableError(err error) bool {
+	return errs.IsAErrorMessageContains[*awstypes.InvalidParametersException](err, "profile does not exist") ||
+		errs.IsAErrorMessageContains[*awstypes.InvalidStateException](err, "under change") ||
+		errs.IsAErrorMessageContains[*awstypes.InvalidStateException](err, "in progress") ||
+		tfawserr.ErrCodeEquals(err, errCodeThrottlingException)
+}
+
+// ignoreRecordErrors returns an error unless the specified provisioning record failed only with errors whose codes are in ignoreCodes.
+// Ignored record errors are logged as warnings.
+func ignoreRecordErrors(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, recordID string, ignoreCodes []string) error {
+	output, err := findRecordByID(ctx, conn, acceptLanguage, recordID)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+		return fmt.Errorf("reading Service Catalog Record (%s): %w", recordID, err)
 	}
 
-	if _, err := waitProvisionedProductReady(ctx, conn, d.Get("accept_language").(string), d.Id(), "", d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Provisioned Product (%s) update: %s", d.Id(), err)
+	recordErrors := output.RecordDetail.RecordErrors
+
+	if len(recordErrors) == 0 {
+		return fmt.Errorf("Service Catalog Record (%s) has no record errors to ignore", recordID)
 	}
 
-	return append(diags, resourceProvisionedProductRead(ctx, d, meta)...)
+	for _, v := range recordErrors {
+		if code := aws.ToString(v.Code); !slices.Contains(ignoreCodes, code) {
+			return fmt.Errorf("Service Catalog Record (%s) error is not ignorable: %s: %s", recordID, code, aws.ToString(v.Description))
+		}
+	}
+
+	for _, v := range recordErrors {
+		log.Printf("[WARN] Ignoring Service Catalog Record (%s) error: %s: %s", recordID, aws.ToString(v.Code), aws.ToString(v.Description))
+	}
+
+	return nil
 }
 
 func resourceProvisionedProductDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+
+	if v, ok := d.GetOk(names.AttrSkipDestroy); ok && v.(bool) {
+		log.Printf("[DEBUG] Retaining Service Catalog Provisioned Product: %s", d.Id())
+		return diags
+	}
+
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
 	input := &servicecatalog.TerminateProvisionedProductInput{
@@ -740,6 +999,10 @@ func expandUpdateProvisioningPreferences(tfMap map[string]interface{}) *awstypes
 		apiObject.StackSetMaxConcurrencyPercentage = aws.Int32(int32(v))
 	}
 
+	if v, ok := tfMap["operation_type"].(string); ok && v != "" {
+		apiObject.StackSetOperationType = awstypes.StackSetOperationType(v)
+	}
+
 	if v, ok := tfMap["regions"].([]interface{}); ok && len(v) > 0 {
 		apiObject.StackSetRegions = flex.ExpandStringValueList(v)
 	}
@@ -761,6 +1024,24 @@ func flattenCloudWatchDashboards(apiObjects []awstypes.CloudWatchDashboard) []*s
 	return tfList
 }
 
+func flattenRecordOutputValues(apiObjects []awstypes.RecordOutput) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	for _, apiObject := range apiObjects {
+		if apiObject.OutputKey == nil {
+			continue
+		}
+
+		tfMap[aws.ToString(apiObject.OutputKey)] = aws.ToString(apiObject.OutputValue)
+	}
+
+	return tfMap
+}
+
 func flattenRecordOutputs(apiObjects []awstypes.RecordOutput) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var noChangesStatusMessageRegexp = regexache.MustCompile(`(?i)didn't contain changes|no updates are to be performed`)
+
+// @SDKDataSource("aws_servicecatalog_provisioned_product_plan_preview", name="Provisioned Product Plan Preview")
+func dataSourceProvisionedProductPlanPreview() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProvisionedProductPlanPreviewRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(ProvisionedProductPlanReadyTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
+			},
+			"added_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"modified_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"outputs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"path_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"provisioned_product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"provisioning_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"use_previous_value": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"removed_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrScope: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceProvisionedProductPlanPreviewRead creates, and then deletes, a provisioned product plan.
+// The plan only previews template and parameter changes and does not detect drift.
+func dataSourceProvisionedProductPlanPreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	acceptLanguage, provisionedProductID := acceptLanguageOrDefault(ctx, d, meta), d.Get("provisioned_product_id").(string)
+	output, err := conn.DescribeProvisionedProduct(ctx, &servicecatalog.DescribeProvisionedProductInput{
+		AcceptLanguage: aws.String(acceptLanguage),
+		Id:             aws.String(provisionedProductID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Provisioned Product (%s): %s", provisionedProductID, err)
+	}
+
+	if output == nil || output.ProvisionedProductDetail == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Provisioned Product (%s): empty result", provisionedProductID)
+	}
+
+	detail := output.ProvisionedProductDetail
+
+	// Plans can only be created against a provisioned product that is not already changing or failed.
+	if status := detail.Status; status != awstypes.ProvisionedProductStatusAvailable {
+		return sdkdiag.AppendErrorf(diags, "Service Catalog Provisioned Product (%s) status is %s, must be %s to plan resource changes", provisionedProductID, status, awstypes.ProvisionedProductStatusAvailable)
+	}
+
+	outputs, err := findProvisionedProductOutputs(ctx, conn, acceptLanguage, provisionedProductID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Provisioned Product (%s) outputs: %s", provisionedProductID, err)
+	}
+
+	provisioningArtifactID := aws.ToString(detail.ProvisioningArtifactId)
+	if v, ok := d.GetOk("provisioning_artifact_id"); ok {
+		provisioningArtifactID = v.(string)
+	}
+
+	input := &servicecatalog.CreateProvisionedProductPlanInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		IdempotencyToken:       aws.String(id.UniqueId()),
+		PlanName:               aws.String(id.PrefixedUniqueId("terraform-")),
+		PlanType:               awstypes.ProvisionedProductPlanTypeCloudformation,
+		ProductId:              detail.ProductId,
+		ProvisionedProductName: detail.Name,
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ProvisioningParameters = expandUpdateProvisioningParameters(v.([]interface{}))
+	}
+
+	plan, err := conn.CreateProvisionedProductPlan(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioned Product (%s) plan: %s", provisionedProductID, err)
+	}
+
+	planID := aws.ToString(plan.PlanId)
+
+	// The plan is only needed to compute the resource changes, so always clean it up.
+	defer func() {
+		log.Printf("[DEBUG] Deleting Service Catalog Provisioned Product Plan: %s", planID)
+		_, err := conn.DeleteProvisionedProductPlan(ctx, &servicecatalog.DeleteProvisionedProductPlanInput{
+			AcceptLanguage: aws.String(acceptLanguage),
+			IgnoreErrors:   true,
+			PlanId:         aws.String(planID),
+		})
+
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "deleting Service Catalog Provisioned Product Plan (%s): %s", planID, err)
+		}
+	}()
+
+	planOutput, err := waitProvisionedProductPlanReady(ctx, conn, acceptLanguage, planID, d.Timeout(schema.TimeoutRead))
+
+	// CloudFormation fails to create a change set that contains no changes.
+	if err != nil && planOutput != nil && provisionedProductPlanHasNoChanges(planOutput.ProvisionedProductPlanDetails) {
+		planOutput.ResourceChanges, err = nil, nil
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Provisioned Product Plan (%s) create: %s", planID, err)
+	}
+
+	d.SetId(provisionedProductID)
+	d.Set("accept_language", acceptLanguage)
+	d.Set("added_resources", resourceChangeLogicalIDs(planOutput.ResourceChanges, awstypes.ChangeActionAdd))
+	d.Set("modified_resources", resourceChangeLogicalIDs(planOutput.ResourceChanges, awstypes.ChangeActionModify))
+	if err := d.Set("outputs", flattenRecordOutputs(outputs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting outputs: %s", err)
+	}
+	d.Set("provisioning_artifact_id", provisioningArtifactID)
+	d.Set("removed_resources", resourceChangeLogicalIDs(planOutput.ResourceChanges, awstypes.ChangeActionRemove))
+	if err := d.Set("resource_changes", flattenResourceChanges(planOutput.ResourceChanges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_changes: %s", err)
+	}
+
+	return diags
+}
+
+func findProvisionedProductOutputs(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, provisionedProductID string) ([]awstypes.RecordOutput, error) {
+	input := &servicecatalog.GetProvisionedProductOutputsInput{
+		AcceptLanguage:       aws.String(acceptLanguage),
+		ProvisionedProductId: aws.String(provisionedProductID),
+	}
+	var output []awstypes.RecordOutput
+
+	pages := servicecatalog.NewGetProvisionedProductOutputsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Outputs...)
+	}
+
+	return output, nil
+}
+
+func provisionedProductPlanHasNoChanges(apiObject *awstypes.ProvisionedProductPlanDetails) bool {
+	return apiObject != nil && apiObject.Status == awstypes.ProvisionedProductPlanStatusCreateFailed && noChangesStatusMessageRegexp.MatchString(aws.ToString(apiObject.StatusMessage))
+}
+
+// resourceChangeLogicalIDs returns the logical IDs of the resources with the specified change action.
+func resourceChangeLogicalIDs(apiObjects []awstypes.ResourceChange, action awstypes.ChangeAction) []string {
+	ids := []string{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject.Action == action {
+			ids = append(ids, aws.ToString(apiObject.LogicalResourceId))
+		}
+	}
+
+	return ids
+}
+
+func flattenResourceChanges(apiObjects []awstypes.ResourceChange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenResourceChange(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenResourceChange(apiObject awstypes.ResourceChange) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrAction: string(apiObject.Action),
+		"replacement":    string(apiObject.Replacement),
+		names.AttrScope:  enum.Slice(apiObject.Scope...),
+	}
+
+	if apiObject.LogicalResourceId != nil {
+		tfMap["logical_resource_id"] = aws.ToString(apiObject.LogicalResourceId)
+	}
+	if apiObject.PhysicalResourceId != nil {
+		tfMap["physical_resource_id"] = aws.ToString(apiObject.PhysicalResourceId)
+	}
+	if apiObject.ResourceType != nil {
+		tfMap[names.AttrResourceType] = aws.ToString(apiObject.ResourceType)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogProvisionedProductPlanPreviewDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicecatalog_provisioned_product_plan_preview.test"
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductPlanPreviewDataSourceConfig_basic(rName, "10.1.0.0/16", "10.2.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "added_resources.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "modified_resources.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "modified_resources.0", "MyVPC"),
+					resource.TestCheckResourceAttr(dataSourceName, "outputs.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "outputs.*", map[string]string{
+						names.AttrDescription: "VPC ID",
+						names.AttrKey:         "VpcID",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "provisioning_artifact_id", resourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "removed_resources.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_changes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_changes.0.action", "MODIFY"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_changes.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_changes.0.physical_resource_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_changes.0.replacement", "TRUE"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_changes.0.resource_type", "AWS::EC2::VPC"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProvisionedProductPlanPreviewDataSourceConfig_basic(rName, vpcCidr, plannedVPCCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductConfig_basic(rName, vpcCidr), fmt.Sprintf(`
+data "aws_servicecatalog_provisioned_product_plan_preview" "test" {
+  provisioned_product_id = aws_servicecatalog_provisioned_product.test.id
+  path_id                = aws_servicecatalog_provisioned_product.test.path_id
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[1]q
+  }
+
+  provisioning_parameters {
+    key                = "LeaveMeEmpty"
+    use_previous_value = true
+  }
+}
+`, plannedVPCCidr))
+}
@@ -7,12 +7,16 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/aws/smithy-go"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -23,6 +27,141 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIgnoreRecordErrors(t *testing.T) {
+	t.Parallel()
+
+	const recordID = "rec-abcdefghijklm"
+
+	testCases := map[string]struct {
+		recordErrors []awstypes.RecordError
+		ignoreCodes  []string
+		expectError  bool
+	}{
+		"ignorable code": {
+			recordErrors: []awstypes.RecordError{
+				{Code: aws.String("StackOutputError"), Description: aws.String("Output not available")},
+			},
+			ignoreCodes: []string{"StackOutputError"},
+		},
+		"all codes ignorable": {
+			recordErrors: []awstypes.RecordError{
+				{Code: aws.String("StackOutputError"), Description: aws.String("Output not available")},
+				{Code: aws.String("TagError"), Description: aws.String("Tag not applied")},
+			},
+			ignoreCodes: []string{"StackOutputError", "TagError"},
+		},
+		"non-ignorable code": {
+			recordErrors: []awstypes.RecordError{
+				{Code: aws.String("StackOutputError"), Description: aws.String("Output not available")},
+				{Code: aws.String("AmazonCloudFormationException"), Description: aws.String("Stack update failed")},
+			},
+			ignoreCodes: []string{"StackOutputError"},
+			expectError: true,
+		},
+		"no record errors": {
+			ignoreCodes: []string{"StackOutputError"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			conn := newMockClient(func(_ context.Context, input any) (any, error) {
+				if v, ok := input.(*servicecatalog.DescribeRecordInput); ok && aws.ToString(v.Id) == recordID {
+					return &servicecatalog.DescribeRecordOutput{
+						RecordDetail: &awstypes.RecordDetail{
+							RecordErrors: testCase.recordErrors,
+							RecordId:     aws.String(recordID),
+							Status:       awstypes.RecordStatusFailed,
+						},
+					}, nil
+				}
+				return nil, fmt.Errorf("unexpected operation input: %T", input)
+			})
+
+			err := tfservicecatalog.IgnoreRecordErrors(ctx, conn, "", recordID, testCase.ignoreCodes)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("IgnoreRecordErrors() error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestUpdateProvisionedProduct_retry(t *testing.T) {
+	t.Parallel()
+
+	const provisionedProductID = "pp-abcdefghijklm"
+
+	testCases := map[string]struct {
+		err           error
+		expectedCalls int
+		expectError   bool
+	}{
+		"throttling": {
+			err:           &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"},
+			expectedCalls: 2,
+		},
+		"invalid state under change": {
+			err:           &awstypes.InvalidStateException{Message: aws.String("Provisioned product is under change")},
+			expectedCalls: 2,
+		},
+		"invalid state operation in progress": {
+			err:           &awstypes.InvalidStateException{Message: aws.String("Provisioned product has an operation in progress")},
+			expectedCalls: 2,
+		},
+		"invalid state tainted": {
+			err:           &awstypes.InvalidStateException{Message: aws.String("Provisioned product is in TAINTED state")},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		"profile does not exist": {
+			err:           &awstypes.InvalidParametersException{Message: aws.String("Launch role profile does not exist")},
+			expectedCalls: 2,
+		},
+		"invalid parameters": {
+			err:           &awstypes.InvalidParametersException{Message: aws.String("Provisioning artifact not found")},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			var calls int
+
+			// The mock fails the first call with the test case's error and then succeeds.
+			conn := newMockClient(func(_ context.Context, input any) (any, error) {
+				if _, ok := input.(*servicecatalog.UpdateProvisionedProductInput); ok {
+					calls++
+					if calls == 1 {
+						return nil, testCase.err
+					}
+					return &servicecatalog.UpdateProvisionedProductOutput{}, nil
+				}
+				return nil, fmt.Errorf("unexpected operation input: %T", input)
+			})
+
+			err := tfservicecatalog.UpdateProvisionedProduct(ctx, conn, &servicecatalog.UpdateProvisionedProductInput{
+				ProvisionedProductId: aws.String(provisionedProductID),
+			}, time.Minute)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("UpdateProvisionedProduct() error = %v, expectError = %t", err, want)
+			}
+			if got, want := calls, testCase.expectedCalls; got != want {
+				t.Errorf("UpdateProvisionedProduct calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
 func TestAccServiceCatalogProvisionedProduct_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -74,6 +213,7 @@ func TestAccServiceCatalogProvisionedProduct_basic(t *testing.T) {
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
+					names.AttrSkipDestroy,
 				},
 			},
 		},
@@ -140,6 +280,7 @@ func TestAccServiceCatalogProvisionedProduct_update(t *testing.T) {
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
+					names.AttrSkipDestroy,
 				},
 			},
 		},
@@ -180,6 +321,7 @@ func TestAccServiceCatalogProvisionedProduct_stackSetProvisioningPreferences(t *
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
+					names.AttrSkipDestroy,
 					"stack_set_provisioning_preferences",
 				},
 			},
@@ -207,6 +349,39 @@ func TestAccServiceCatalogProvisionedProduct_stackSetProvisioningPreferences(t *
 	})
 }
 
+func TestAccServiceCatalogProvisionedProduct_StackSetProvisioningPreferences_operationType(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_stackSetprovisioningPreferences(rName, "10.1.0.0/16", 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "stack_set_provisioning_preferences.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stack_set_provisioning_preferences.0.operation_type", ""),
+				),
+			},
+			{
+				Config: testAccProvisionedProductConfig_stackSetProvisioningPreferencesOperationType(rName, "10.2.0.0/16", "UPDATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "stack_set_provisioning_preferences.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stack_set_provisioning_preferences.0.operation_type", "UPDATE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProvisionedProductStatusAvailable)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisionedProduct_ProductName_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -250,6 +425,7 @@ func TestAccServiceCatalogProvisionedProduct_ProductName_update(t *testing.T) {
 					"provisioning_artifact_name",
 					"provisioning_parameters",
 					"retain_physical_resources",
+					names.AttrSkipDestroy,
 				},
 			},
 		},
@@ -293,6 +469,42 @@ func TestAccServiceCatalogProvisionedProduct_ProvisioningArtifactName_update(t *
 	})
 }
 
+func TestAccServiceCatalogProvisionedProduct_ProvisioningArtifactID_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	artifactResourceName := "aws_servicecatalog_provisioning_artifact.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	artifactName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod1, pprod2 awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_basic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod1),
+				),
+			},
+			{
+				Config: testAccProvisionedProductConfig_ProvisioningArtifactID_update(rName, "10.1.0.0/16", artifactName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod2),
+					testAccCheckProvisionedProductNotRecreated(&pprod1, &pprod2),
+					testAccCheckProvisionedProductProvisioningArtifactIDChanged(&pprod1, &pprod2),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_artifact_id", artifactResourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProvisionedProductStatusAvailable)),
+					resource.TestCheckResourceAttrPair(resourceName, "last_successful_provisioning_record_id", resourceName, "last_provisioning_record_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisionedProduct_computedOutputs(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -320,6 +532,9 @@ func TestAccServiceCatalogProvisionedProduct_computedOutputs(t *testing.T) {
 						names.AttrKey:         "VPCPrimaryCIDR",
 						names.AttrValue:       "10.1.0.0/16",
 					}),
+					resource.TestCheckResourceAttr(resourceName, "record_outputs.%", acctest.Ct3),
+					resource.TestCheckResourceAttrSet(resourceName, "record_outputs.VpcID"),
+					resource.TestCheckResourceAttr(resourceName, "record_outputs.VPCPrimaryCIDR", "10.1.0.0/16"),
 				),
 			},
 			{
@@ -336,6 +551,39 @@ func TestAccServiceCatalogProvisionedProduct_computedOutputs(t *testing.T) {
 						names.AttrKey:         "VPCPrimaryCIDR",
 						names.AttrValue:       "10.1.0.1/16",
 					}),
+					resource.TestCheckResourceAttr(resourceName, "record_outputs.%", acctest.Ct3),
+					resource.TestCheckResourceAttrSet(resourceName, "record_outputs.VpcID"),
+					resource.TestCheckResourceAttr(resourceName, "record_outputs.VPCPrimaryCIDR", "10.1.0.1/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisionedProduct_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_skipDestroy(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccProvisionedProductTemplateURLBaseConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductRetained(ctx, &pprod),
 				),
 			},
 		},
@@ -418,6 +666,112 @@ func TestAccServiceCatalogProvisionedProduct_errorOnUpdate(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProvisionedProduct_importByName(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_basic(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName,
+				ImportStateCheck:  testAccCheckProvisionedProductImportedParameters("LeaveMeEmpty", "VPCPrimaryCIDR"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"accept_language",
+					"ignore_errors",
+					"provisioning_artifact_name",
+					"provisioning_parameters",
+					"retain_physical_resources",
+					names.AttrSkipDestroy,
+				},
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisionedProduct_recoverTainted(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_recoverTainted(rName, "10.1.0.0/16", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, "recover_tainted", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProvisionedProductStatusAvailable)),
+				),
+			},
+			{
+				Config:      testAccProvisionedProductConfig_recoverTainted(rName, "10.1.0.0/16", "NotEmpty"),
+				ExpectError: regexache.MustCompile(`AmazonCloudFormationException  Unresolved resource dependencies \[MyVPC\] in the Outputs block of the template`),
+			},
+			{
+				// The failed update leaves the provisioned product TAINTED; recover_tainted forces a new update.
+				Config: testAccProvisionedProductConfig_recoverTainted(rName, "10.1.0.0/16", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProvisionedProductStatusAvailable)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisionedProduct_ignoreUpdateErrorCodesNoUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod1, pprod2 awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedProductConfig_ignoreUpdateErrorCodes(rName, "10.1.0.0/16", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod1),
+					resource.TestCheckResourceAttr(resourceName, "ignore_update_error_codes.#", acctest.Ct0),
+				),
+			},
+			{
+				// Changing only ignore_update_error_codes must not call UpdateProvisionedProduct.
+				Config: testAccProvisionedProductConfig_ignoreUpdateErrorCodes(rName, "10.1.0.0/16", "StackOutputError"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod2),
+					testAccCheckProvisionedProductNotUpdated(&pprod1, &pprod2),
+					resource.TestCheckResourceAttr(resourceName, "ignore_update_error_codes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ignore_update_error_codes.*", "StackOutputError"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisionedProduct_productTagUpdateAfterError(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -487,6 +841,38 @@ func testAccCheckProvisionedProductDestroy(ctx context.Context) resource.TestChe
 	}
 }
 
+// testAccCheckProvisionedProductImportedParameters checks that the provisioning parameters were imported
+// as use_previous_value markers for exactly the specified keys.
+func testAccCheckProvisionedProductImportedParameters(keys ...string) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d", len(s))
+		}
+
+		attributes := s[0].Attributes
+
+		if got, want := attributes["provisioning_parameters.#"], strconv.Itoa(len(keys)); got != want {
+			return fmt.Errorf("provisioning_parameters.# = %s, want %s", got, want)
+		}
+
+		var got []string
+		for i := range keys {
+			if v := attributes[fmt.Sprintf("provisioning_parameters.%d.use_previous_value", i)]; v != acctest.CtTrue {
+				return fmt.Errorf("provisioning_parameters.%d.use_previous_value = %q, want %q", i, v, acctest.CtTrue)
+			}
+
+			got = append(got, attributes[fmt.Sprintf("provisioning_parameters.%d.key", i)])
+		}
+
+		slices.Sort(got)
+		if !slices.Equal(got, keys) {
+			return fmt.Errorf("provisioning_parameters keys = %q, want %q", got, keys)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckProvisionedProductExists(ctx context.Context, resourceName string, pprod *awstypes.ProvisionedProductDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -511,6 +897,56 @@ func testAccCheckProvisionedProductExists(ctx context.Context, resourceName stri
 // testAccCheckProvisionedProductProvisioningArtifactIDChanged verifies that the provisioned artifact
 // ID differs between two provisioned products. If either provisioned product details or the provisioned
 // artifact ID are null, the check will fail.
+// testAccCheckProvisionedProductRetained verifies that a provisioned product removed from
+// configuration with skip_destroy set still exists, then terminates it so that the
+// remaining test resources can be destroyed.
+func testAccCheckProvisionedProductRetained(ctx context.Context, pprod *awstypes.ProvisionedProductDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
+		id := aws.ToString(pprod.Id)
+
+		if _, err := tfservicecatalog.WaitProvisionedProductReady(ctx, conn, tfservicecatalog.AcceptLanguageEnglish, id, "", tfservicecatalog.ProvisionedProductReadyTimeout); err != nil {
+			return fmt.Errorf("Service Catalog Provisioned Product (%s) was not retained: %w", id, err)
+		}
+
+		input := &servicecatalog.TerminateProvisionedProductInput{
+			AcceptLanguage:       aws.String(tfservicecatalog.AcceptLanguageEnglish),
+			ProvisionedProductId: aws.String(id),
+			TerminateToken:       aws.String(sdkacctest.RandString(16)),
+		}
+
+		if _, err := conn.TerminateProvisionedProduct(ctx, input); err != nil {
+			return fmt.Errorf("terminating Service Catalog Provisioned Product (%s): %w", id, err)
+		}
+
+		if err := tfservicecatalog.WaitProvisionedProductTerminated(ctx, conn, tfservicecatalog.AcceptLanguageEnglish, id, "", tfservicecatalog.ProvisionedProductDeleteTimeout); err != nil {
+			return fmt.Errorf("waiting for Service Catalog Provisioned Product (%s) terminate: %w", id, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProvisionedProductNotRecreated(pprod1, pprod2 *awstypes.ProvisionedProductDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(pprod1.Id), aws.ToString(pprod2.Id); before != after {
+			return fmt.Errorf("provisioned product was recreated. got: %s, expected: %s", after, before)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProvisionedProductNotUpdated(pprod1, pprod2 *awstypes.ProvisionedProductDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(pprod1.LastProvisioningRecordId), aws.ToString(pprod2.LastProvisioningRecordId); before != after {
+			return fmt.Errorf("provisioned product was updated. got provisioning record: %s, expected: %s", after, before)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckProvisionedProductProvisioningArtifactIDChanged(pprod1, pprod2 *awstypes.ProvisionedProductDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if pprod1 == nil || pprod2 == nil ||
@@ -809,6 +1245,29 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr))
 }
 
+func testAccProvisionedProductConfig_skipDestroy(rName, vpcCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  skip_destroy               = true
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+}
+`, rName, vpcCidr))
+}
+
 func testAccProvisionedProductConfig_computedOutputs(rName, vpcCidr string) string {
 	return acctest.ConfigCompose(testAccProvisionedProductPhysicalTemplateIDBaseConfig(rName),
 		fmt.Sprintf(`
@@ -862,6 +1321,38 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr, failureToleranceCount, maxConcurrencyCount))
 }
 
+func testAccProvisionedProductConfig_stackSetProvisioningPreferencesOperationType(rName, vpcCidr, operationType string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+
+  stack_set_provisioning_preferences {
+    accounts                = [data.aws_caller_identity.current.account_id]
+    regions                 = [data.aws_region.current.name]
+    failure_tolerance_count = 1
+    max_concurrency_count   = 2
+    operation_type          = %[3]q
+  }
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+}
+`, rName, vpcCidr, operationType))
+}
+
 func testAccProvisionedProductConfig_productName(rName, vpcCidr, productName string) string {
 	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(productName),
 		fmt.Sprintf(`
@@ -918,6 +1409,40 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr, artifactName))
 }
 
+func testAccProvisionedProductConfig_ProvisioningArtifactID_update(rName, vpcCidr, artifactName string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  product_id   = aws_servicecatalog_product.test.id
+  template_url = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  name         = %[3]q
+  type         = "CLOUD_FORMATION_TEMPLATE"
+}
+
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                     = %[1]q
+  product_id               = aws_servicecatalog_product.test.id
+  provisioning_artifact_id = aws_servicecatalog_provisioning_artifact.test.provisioning_artifact_id
+  path_id                  = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+
+  # Leave this here to test tag behavior on Update
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, vpcCidr, artifactName))
+}
+
 // Because the `provisioning_parameter` "LeaveMeEmpty" is not empty, this configuration results in an error.
 // The `status_message` will be:
 // AmazonCloudFormationException  Unresolved resource dependencies [MyVPC] in the Outputs block of the template
@@ -943,6 +1468,52 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr))
 }
 
+func testAccProvisionedProductConfig_recoverTainted(rName, vpcCidr, leaveMeEmpty string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  recover_tainted            = true
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = %[3]q
+  }
+}
+`, rName, vpcCidr, leaveMeEmpty))
+}
+
+func testAccProvisionedProductConfig_ignoreUpdateErrorCodes(rName, vpcCidr, ignoreUpdateErrorCode string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                       = %[1]q
+  product_id                 = aws_servicecatalog_product.test.id
+  provisioning_artifact_name = %[1]q
+  path_id                    = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  ignore_update_error_codes  = compact([%[3]q])
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+}
+`, rName, vpcCidr, ignoreUpdateErrorCode))
+}
+
 func testAccProvisionedProductConfig_productTagUpdateAfterError_valid(rName, bucketName, tagValue string) string {
 	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLSimpleBaseConfig(rName),
 		fmt.Sprintf(`
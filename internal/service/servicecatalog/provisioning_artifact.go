@@ -46,7 +46,7 @@ func resourceProvisioningArtifact() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"active": {
@@ -113,6 +113,8 @@ func resourceProvisioningArtifact() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.ProvisioningArtifactType](),
 			},
 		},
+
+		CustomizeDiff: customizeDiffAcceptLanguage,
 	}
 }
 
@@ -169,6 +171,10 @@ func resourceProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(provisioningArtifactID(aws.ToString(output.ProvisioningArtifactDetail.Id), d.Get("product_id").(string)))
 
+	if _, err := waitProvisioningArtifactReady(ctx, conn, aws.ToString(output.ProvisioningArtifactDetail.Id), d.Get("product_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Provisioning Artifact (%s) create: %s", d.Id(), err)
+	}
+
 	// Active and Guidance are not fields of CreateProvisioningArtifact but are fields of UpdateProvisioningArtifact.
 	// In order to set these to non-default values, you must create and then update.
 
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
@@ -140,6 +142,45 @@ func TestAccServiceCatalogProvisioningArtifact_update(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProvisioningArtifact_active(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_active(rName, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtTrue),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
+				),
+			},
+			{
+				Config: testAccProvisioningArtifactConfig_active(rName, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccProvisioningArtifactConfig_active(rName, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisioningArtifact_physicalID(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioning_artifact.test"
@@ -183,6 +224,169 @@ func TestAccServiceCatalogProvisioningArtifact_physicalID(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogProvisioningArtifact_templateSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningArtifactConfig_templateSourceBoth(rName),
+				ExpectError: regexache.MustCompile("only one of `template_url,template_physical_id` can be specified"),
+			},
+			{
+				Config:      testAccProvisioningArtifactConfig_templateSourceNone(rName),
+				ExpectError: regexache.MustCompile("one of `template_url,template_physical_id` must be specified"),
+			},
+		},
+	})
+}
+
+func TestStatusProvisioningArtifact(t *testing.T) {
+	t.Parallel()
+
+	const (
+		artifactID = "pa-abcdefghijklm"
+		productID  = "prod-abcdefghijklm"
+	)
+
+	testCases := map[string]struct {
+		output         *servicecatalog.DescribeProvisioningArtifactOutput
+		err            error
+		expectedStatus string
+		expectError    bool
+	}{
+		"available": {
+			output: &servicecatalog.DescribeProvisioningArtifactOutput{
+				ProvisioningArtifactDetail: &awstypes.ProvisioningArtifactDetail{Id: aws.String(artifactID)},
+				Status:                     awstypes.StatusAvailable,
+			},
+			expectedStatus: string(awstypes.StatusAvailable),
+		},
+		"creating": {
+			output: &servicecatalog.DescribeProvisioningArtifactOutput{
+				ProvisioningArtifactDetail: &awstypes.ProvisioningArtifactDetail{Id: aws.String(artifactID)},
+				Status:                     awstypes.StatusCreating,
+			},
+			expectedStatus: string(awstypes.StatusCreating),
+		},
+		"failed": {
+			output: &servicecatalog.DescribeProvisioningArtifactOutput{
+				ProvisioningArtifactDetail: &awstypes.ProvisioningArtifactDetail{Id: aws.String(artifactID)},
+				Status:                     awstypes.StatusFailed,
+			},
+			expectedStatus: string(awstypes.StatusFailed),
+		},
+		"not found": {
+			err:            &awstypes.ResourceNotFoundException{Message: aws.String("Provisioning artifact not found")},
+			expectedStatus: tfservicecatalog.StatusNotFound,
+			expectError:    true,
+		},
+		"error": {
+			err:            &awstypes.InvalidParametersException{Message: aws.String("Invalid product ID")},
+			expectedStatus: string(awstypes.StatusFailed),
+			expectError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			conn := newMockClient(func(_ context.Context, input any) (any, error) {
+				if _, ok := input.(*servicecatalog.DescribeProvisioningArtifactInput); ok {
+					if testCase.err != nil {
+						return nil, testCase.err
+					}
+					return testCase.output, nil
+				}
+				return nil, fmt.Errorf("unexpected operation input: %T", input)
+			})
+
+			_, status, err := tfservicecatalog.StatusProvisioningArtifact(ctx, conn, artifactID, productID)()
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("StatusProvisioningArtifact() error = %v, expectError = %t", err, want)
+			}
+			if got, want := status, testCase.expectedStatus; got != want {
+				t.Errorf("status = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitProvisioningArtifactReady(t *testing.T) {
+	t.Parallel()
+
+	const (
+		artifactID = "pa-abcdefghijklm"
+		productID  = "prod-abcdefghijklm"
+	)
+
+	testCases := map[string]struct {
+		statuses      []awstypes.Status
+		info          map[string]string
+		expectedError string
+	}{
+		"creating then available": {
+			statuses: []awstypes.Status{awstypes.StatusCreating, awstypes.StatusAvailable},
+		},
+		"failed": {
+			statuses:      []awstypes.Status{awstypes.StatusCreating, awstypes.StatusFailed},
+			info:          map[string]string{"LoadTemplateFromURL": "https://example.com/template.json"},
+			expectedError: `unexpected state 'FAILED'.+last error: provisioning artifact \(pa-abcdefghijklm\) info: map\[LoadTemplateFromURL:https://example.com/template.json\]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			var calls int
+
+			// The mock returns the test case's statuses in order, repeating the last one.
+			conn := newMockClient(func(_ context.Context, input any) (any, error) {
+				if _, ok := input.(*servicecatalog.DescribeProvisioningArtifactInput); ok {
+					status := testCase.statuses[min(calls, len(testCase.statuses)-1)]
+					calls++
+					return &servicecatalog.DescribeProvisioningArtifactOutput{
+						Info:                       testCase.info,
+						ProvisioningArtifactDetail: &awstypes.ProvisioningArtifactDetail{Id: aws.String(artifactID)},
+						Status:                     status,
+					}, nil
+				}
+				return nil, fmt.Errorf("unexpected operation input: %T", input)
+			})
+
+			output, err := tfservicecatalog.WaitProvisioningArtifactReady(ctx, conn, artifactID, productID, time.Minute)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if got, want := output.Status, awstypes.StatusAvailable; got != want {
+					t.Errorf("status = %q, want %q", got, want)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q", testCase.expectedError)
+			}
+			if !regexache.MustCompile(testCase.expectedError).MatchString(err.Error()) {
+				t.Errorf("error = %q, want match for %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
 func testAccCheckProvisioningArtifactDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -328,6 +532,19 @@ resource "aws_servicecatalog_provisioning_artifact" "test" {
 `, rName))
 }
 
+func testAccProvisioningArtifactConfig_active(rName, domain string, active bool) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  active                      = %[2]t
+  disable_template_validation = true
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, active))
+}
+
 func testAccProvisioningArtifactConfig_update(rName, domain string) string {
 	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
 resource "aws_servicecatalog_provisioning_artifact" "test" {
@@ -407,3 +624,25 @@ resource "aws_servicecatalog_provisioning_artifact" "test" {
 }
 `, rName))
 }
+
+func testAccProvisioningArtifactConfig_templateSourceBoth(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  name                 = %[1]q
+  product_id           = "prod-abcdefghijklm"
+  template_physical_id = %[1]q
+  template_url         = "https://s3.amazonaws.com/%[1]s/template.json"
+  type                 = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName)
+}
+
+func testAccProvisioningArtifactConfig_templateSourceNone(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  name       = %[1]q
+  product_id = "prod-abcdefghijklm"
+  type       = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName)
+}
@@ -29,8 +29,8 @@ func dataSourceProvisioningArtifacts() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"accept_language": {
 				Type:         schema.TypeString,
-				Default:      acceptLanguageEnglish,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"product_id": {
@@ -81,9 +81,9 @@ func dataSourceProvisioningArtifactsRead(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
-	productID := d.Get("product_id").(string)
+	acceptLanguage, productID := acceptLanguageOrDefault(ctx, d, meta), d.Get("product_id").(string)
 	input := &servicecatalog.ListProvisioningArtifactsInput{
-		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
+		AcceptLanguage: aws.String(acceptLanguage),
 		ProductId:      aws.String(productID),
 	}
 
@@ -94,6 +94,7 @@ func dataSourceProvisioningArtifactsRead(ctx context.Context, d *schema.Resource
 	}
 
 	d.SetId(productID)
+	d.Set("accept_language", acceptLanguage)
 	if err := d.Set("provisioning_artifact_details", flattenProvisioningArtifactDetails(output.ProvisioningArtifactDetails)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting provisioning_artifact_details: %s", err)
 	}
@@ -5,7 +5,9 @@ package servicecatalog
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
@@ -45,7 +47,7 @@ func resourceServiceAction() *schema.Resource {
 			"accept_language": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      acceptLanguageEnglish,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
 			},
 			"definition": {
@@ -55,8 +57,9 @@ func resourceServiceAction() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"assume_role": { // ServiceActionDefinitionKeyAssumeRole
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validServiceActionAssumeRole,
 						},
 						names.AttrName: { // ServiceActionDefinitionKeyName
 							Type:     schema.TypeString,
@@ -65,7 +68,7 @@ func resourceServiceAction() *schema.Resource {
 						names.AttrParameters: { // ServiceActionDefinitionKeyParameters
 							Type:             schema.TypeString,
 							Optional:         true,
-							ValidateFunc:     validation.StringIsJSON,
+							ValidateFunc:     validation.All(validation.StringIsJSON, validServiceActionParameters),
 							DiffSuppressFunc: suppressEquivalentJSONEmptyNilDiffs,
 						},
 						names.AttrType: {
@@ -82,6 +85,10 @@ func resourceServiceAction() *schema.Resource {
 					},
 				},
 			},
+			"definition_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -91,7 +98,17 @@ func resourceServiceAction() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"number_of_associations": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"read_associations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
+
+		CustomizeDiff: customizeDiffAcceptLanguage,
 	}
 }
 
@@ -99,6 +116,7 @@ func resourceServiceActionCreate(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
+	// The token is unique to this Create and is reused only by the retries below.
 	input := &servicecatalog.CreateServiceActionInput{
 		IdempotencyToken: aws.String(id.UniqueId()),
 		Name:             aws.String(d.Get(names.AttrName).(string)),
@@ -170,15 +188,39 @@ func resourceServiceActionRead(ctx context.Context, d *schema.ResourceData, meta
 
 	sas := output.ServiceActionSummary
 
+	d.Set("definition_type", sas.DefinitionType)
 	d.Set(names.AttrDescription, sas.Description)
 	d.Set(names.AttrName, sas.Name)
 
 	if output.Definition != nil {
-		d.Set("definition", []interface{}{flattenServiceActionDefinition(output.Definition, sas.DefinitionType)})
+		tfMap := flattenServiceActionDefinition(output.Definition, sas.DefinitionType)
+
+		// Parameters are opaque JSON. Keep the configured document when it is equivalent to
+		// the one returned by the API so that keys such as DefaultValue round-trip exactly.
+		if v, ok := tfMap[names.AttrParameters].(string); ok {
+			if old := d.Get("definition.0.parameters").(string); old != "" && jsonEmptyNilEquivalent(old, v) {
+				tfMap[names.AttrParameters] = old
+			}
+		}
+
+		d.Set("definition", []interface{}{tfMap})
 	} else {
 		d.Set("definition", nil)
 	}
 
+	// Listing the associations is an extra API call, so it is only done on request.
+	if d.Get("read_associations").(bool) {
+		associations, err := findProvisioningArtifactsForServiceAction(ctx, conn, d.Get("accept_language").(string), d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing Service Catalog Service Action (%s) associations: %s", d.Id(), err)
+		}
+
+		d.Set("number_of_associations", len(associations))
+	} else {
+		d.Set("number_of_associations", nil)
+	}
+
 	return diags
 }
 
@@ -186,12 +228,16 @@ func resourceServiceActionUpdate(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
 
+	if !d.HasChangesExcept("read_associations") {
+		return append(diags, resourceServiceActionRead(ctx, d, meta)...)
+	}
+
 	input := &servicecatalog.UpdateServiceActionInput{
 		Id: aws.String(d.Id()),
 	}
 
-	if d.HasChange("accept_language") {
-		input.AcceptLanguage = aws.String(d.Get("accept_language").(string))
+	if v, ok := d.GetOk("accept_language"); ok {
+		input.AcceptLanguage = aws.String(v.(string))
 	}
 
 	if d.HasChange("definition") {
@@ -206,7 +252,15 @@ func resourceServiceActionUpdate(ctx context.Context, d *schema.ResourceData, me
 		input.Name = aws.String(d.Get(names.AttrName).(string))
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	if err := updateServiceAction(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Service Catalog Service Action (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceServiceActionRead(ctx, d, meta)...)
+}
+
+func updateServiceAction(ctx context.Context, conn *servicecatalog.Client, input *servicecatalog.UpdateServiceActionInput, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		_, err := conn.UpdateServiceAction(ctx, input)
 
 		if errs.IsAErrorMessageContains[*awstypes.InvalidParametersException](err, "profile does not exist") {
@@ -224,11 +278,15 @@ func resourceServiceActionUpdate(ctx context.Context, d *schema.ResourceData, me
 		_, err = conn.UpdateServiceAction(ctx, input)
 	}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Service Catalog Service Action (%s): %s", d.Id(), err)
+	// Service Catalog can reject renaming a service action that is associated with provisioning artifacts.
+	// The error message isn't documented, so check for associations instead of matching it.
+	if input.Name != nil && (errs.IsA[*awstypes.ResourceInUseException](err) || errs.IsA[*awstypes.InvalidParametersException](err)) {
+		if associations, findErr := findProvisioningArtifactsForServiceAction(ctx, conn, aws.ToString(input.AcceptLanguage), aws.ToString(input.Id)); findErr == nil && len(associations) > 0 {
+			return fmt.Errorf("the service action cannot be renamed while it is associated with provisioning artifacts. Disassociate it first, e.g. by removing its aws_servicecatalog_service_action_association resources, and then change name: %w", err)
+		}
 	}
 
-	return append(diags, resourceServiceActionRead(ctx, d, meta)...)
+	return err
 }
 
 func resourceServiceActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -239,6 +297,10 @@ func resourceServiceActionDelete(ctx context.Context, d *schema.ResourceData, me
 		Id: aws.String(d.Id()),
 	}
 
+	if v, ok := d.GetOk("accept_language"); ok {
+		input.AcceptLanguage = aws.String(v.(string))
+	}
+
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteServiceAction(ctx, input)
 
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_servicecatalog_service_action_association", name="Service Action Association")
+func resourceServiceActionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceActionAssociationCreate,
+		ReadWithoutTimeout:   resourceServiceActionAssociationRead,
+		DeleteWithoutTimeout: resourceServiceActionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceActionAssociationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provisioning_artifact_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_action_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_action_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customizeDiffAcceptLanguage,
+	}
+}
+
+func resourceServiceActionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	acceptLanguage, serviceActionID, productID, provisioningArtifactID := d.Get("accept_language").(string), d.Get("service_action_id").(string), d.Get("product_id").(string), d.Get("provisioning_artifact_id").(string)
+	id := serviceActionAssociationCreateResourceID(serviceActionID, productID, provisioningArtifactID)
+	input := &servicecatalog.AssociateServiceActionWithProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+		ServiceActionId:        aws.String(serviceActionID),
+	}
+
+	_, err := conn.AssociateServiceActionWithProvisioningArtifact(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Service Catalog Service Action Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return findServiceActionAssociation(ctx, conn, acceptLanguage, serviceActionID, productID, provisioningArtifactID)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Service Action Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceServiceActionAssociationRead(ctx, d, meta)...)
+}
+
+func resourceServiceActionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	serviceActionID, productID, provisioningArtifactID, err := serviceActionAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	acceptLanguage := d.Get("accept_language").(string)
+	output, err := findServiceActionAssociation(ctx, conn, acceptLanguage, serviceActionID, productID, provisioningArtifactID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog Service Action Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Service Action Association (%s): %s", d.Id(), err)
+	}
+
+	serviceAction, err := findServiceActionByID(ctx, conn, acceptLanguage, serviceActionID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Service Action (%s): %s", serviceActionID, err)
+	}
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("product_id", productID)
+	d.Set("product_name", output.ProductViewSummary.Name)
+	d.Set("provisioning_artifact_id", provisioningArtifactID)
+	d.Set("provisioning_artifact_name", output.ProvisioningArtifact.Name)
+	d.Set("service_action_id", serviceActionID)
+	if v := serviceAction.ServiceActionSummary; v != nil {
+		d.Set("service_action_name", v.Name)
+	}
+
+	return diags
+}
+
+func resourceServiceActionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	serviceActionID, productID, provisioningArtifactID, err := serviceActionAssociationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	acceptLanguage := d.Get("accept_language").(string)
+	input := &servicecatalog.DisassociateServiceActionFromProvisioningArtifactInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+		ServiceActionId:        aws.String(serviceActionID),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Service Action Association: %s", d.Id())
+	_, err = conn.DisassociateServiceActionFromProvisioningArtifact(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Catalog Service Action Association (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return findServiceActionAssociation(ctx, conn, acceptLanguage, serviceActionID, productID, provisioningArtifactID)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Service Action Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceServiceActionAssociationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	acceptLanguage, serviceActionID, productID, provisioningArtifactID, err := serviceActionAssociationParseImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if acceptLanguage == "" {
+		acceptLanguage = defaultAcceptLanguage(ctx, meta)
+	}
+
+	if _, err := findServiceActionAssociation(ctx, conn, acceptLanguage, serviceActionID, productID, provisioningArtifactID); err != nil {
+		return nil, fmt.Errorf("importing Service Catalog Service Action Association (%s): %w", d.Id(), err)
+	}
+
+	d.SetId(serviceActionAssociationCreateResourceID(serviceActionID, productID, provisioningArtifactID))
+	d.Set("accept_language", acceptLanguage)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const serviceActionAssociationResourceIDSeparator = ","
+
+func serviceActionAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, serviceActionAssociationResourceIDSeparator)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected serviceActionID%[2]sproductID%[2]sprovisioningArtifactID", id, serviceActionAssociationResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// serviceActionAssociationParseImportID parses an import ID of the form serviceActionID,productID,provisioningArtifactID
+// with an optional trailing acceptLanguage, which is returned empty if omitted.
+func serviceActionAssociationParseImportID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, serviceActionAssociationResourceIDSeparator)
+
+	if (len(parts) != 3 && len(parts) != 4) || slices.Contains(parts, "") {
+		return "", "", "", "", fmt.Errorf("unexpected format of import ID (%[1]s), expected serviceActionID%[2]sproductID%[2]sprovisioningArtifactID[%[2]sacceptLanguage]", id, serviceActionAssociationResourceIDSeparator)
+	}
+
+	var acceptLanguage string
+	if len(parts) == 4 {
+		acceptLanguage = parts[3]
+
+		if !slices.Contains(acceptLanguage_Values(), acceptLanguage) {
+			return "", "", "", "", fmt.Errorf("unexpected accept language (%s) in import ID (%s), expected one of %s", acceptLanguage, id, strings.Join(acceptLanguage_Values(), ", "))
+		}
+	}
+
+	return acceptLanguage, parts[0], parts[1], parts[2], nil
+}
+
+func serviceActionAssociationCreateResourceID(serviceActionID, productID, provisioningArtifactID string) string {
+	return strings.Join([]string{serviceActionID, productID, provisioningArtifactID}, serviceActionAssociationResourceIDSeparator)
+}
+
+// findServiceActionAssociation lists the provisioning artifacts associated with the service action, filtered
+// server-side by the service action ID, and returns the one matching the product and provisioning artifact.
+func findServiceActionAssociation(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, serviceActionID, productID, provisioningArtifactID string) (*awstypes.ProvisioningArtifactView, error) {
+	input := &servicecatalog.ListProvisioningArtifactsForServiceActionInput{
+		AcceptLanguage:  aws.String(acceptLanguage),
+		ServiceActionId: aws.String(serviceActionID),
+	}
+
+	pages := servicecatalog.NewListProvisioningArtifactsForServiceActionPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ProvisioningArtifactViews {
+			if v.ProductViewSummary == nil || v.ProvisioningArtifact == nil {
+				continue
+			}
+
+			if aws.ToString(v.ProductViewSummary.ProductId) == productID && aws.ToString(v.ProvisioningArtifact.Id) == provisioningArtifactID {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
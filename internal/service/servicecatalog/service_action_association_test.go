@@ -0,0 +1,457 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFindServiceActionAssociation(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const (
+		serviceActionID        = "act-abcdefghijklm"
+		productID              = "prod-abcdefghijklm"
+		provisioningArtifactID = "pa-abcdefghijklm"
+	)
+	var inputs []*servicecatalog.ListProvisioningArtifactsForServiceActionInput
+
+	conn := newMockClient(func(_ context.Context, input any) (any, error) {
+		if v, ok := input.(*servicecatalog.ListProvisioningArtifactsForServiceActionInput); ok {
+			inputs = append(inputs, v)
+			return &servicecatalog.ListProvisioningArtifactsForServiceActionOutput{
+				ProvisioningArtifactViews: []awstypes.ProvisioningArtifactView{
+					{
+						ProductViewSummary:   &awstypes.ProductViewSummary{ProductId: aws.String("prod-other")},
+						ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String(provisioningArtifactID)},
+					},
+					{
+						ProductViewSummary:   &awstypes.ProductViewSummary{ProductId: aws.String(productID), Name: aws.String("product")},
+						ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String(provisioningArtifactID), Name: aws.String("v1")},
+					},
+				},
+				NextPageToken: aws.String("next"),
+			}, nil
+		}
+		return nil, fmt.Errorf("unexpected operation input: %T", input)
+	})
+
+	output, err := tfservicecatalog.FindServiceActionAssociation(ctx, conn, tfservicecatalog.AcceptLanguageEnglish, serviceActionID, productID, provisioningArtifactID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.ProductViewSummary.ProductId), productID; got != want {
+		t.Errorf("product ID = %s, want %s", got, want)
+	}
+	if got, want := aws.ToString(output.ProductViewSummary.Name), "product"; got != want {
+		t.Errorf("product name = %s, want %s", got, want)
+	}
+	if got, want := aws.ToString(output.ProvisioningArtifact.Name), "v1"; got != want {
+		t.Errorf("provisioning artifact name = %s, want %s", got, want)
+	}
+	if got, want := len(inputs), 1; got != want {
+		t.Fatalf("ListProvisioningArtifactsForServiceAction calls = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(inputs[0].ServiceActionId), serviceActionID; got != want {
+		t.Errorf("ListProvisioningArtifactsForServiceAction service action ID = %s, want %s", got, want)
+	}
+}
+
+func TestFindServiceActionByID(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const (
+		serviceActionID = "act-abcdefghijklm"
+	)
+
+	conn := newMockClient(func(_ context.Context, input any) (any, error) {
+		if v, ok := input.(*servicecatalog.DescribeServiceActionInput); ok {
+			if aws.ToString(v.Id) != serviceActionID {
+				return nil, &awstypes.ResourceNotFoundException{Message: aws.String("service action not found")}
+			}
+			return &servicecatalog.DescribeServiceActionOutput{
+				ServiceActionDetail: &awstypes.ServiceActionDetail{
+					ServiceActionSummary: &awstypes.ServiceActionSummary{
+						Id:   aws.String(serviceActionID),
+						Name: aws.String("restart"),
+					},
+				},
+			}, nil
+		}
+		return nil, fmt.Errorf("unexpected operation input: %T", input)
+	})
+
+	output, err := tfservicecatalog.FindServiceActionByID(ctx, conn, tfservicecatalog.AcceptLanguageEnglish, serviceActionID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.ServiceActionSummary.Name), "restart"; got != want {
+		t.Errorf("service action name = %s, want %s", got, want)
+	}
+
+	_, err = tfservicecatalog.FindServiceActionByID(ctx, conn, tfservicecatalog.AcceptLanguageEnglish, "act-other")
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
+
+func TestFindServiceActionAssociation_pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const (
+		serviceActionID        = "act-abcdefghijklm"
+		productID              = "prod-abcdefghijklm"
+		provisioningArtifactID = "pa-abcdefghijklm"
+	)
+	var inputs []*servicecatalog.ListProvisioningArtifactsForServiceActionInput
+
+	conn := newMockListProvisioningArtifactsForServiceActionClient(&inputs, [][]awstypes.ProvisioningArtifactView{
+		{
+			{
+				ProductViewSummary:   &awstypes.ProductViewSummary{ProductId: aws.String("prod-other")},
+				ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String("pa-other")},
+			},
+		},
+		{
+			{
+				ProductViewSummary:   &awstypes.ProductViewSummary{ProductId: aws.String(productID)},
+				ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String(provisioningArtifactID)},
+			},
+		},
+	})
+
+	output, err := tfservicecatalog.FindServiceActionAssociation(ctx, conn, tfservicecatalog.AcceptLanguageEnglish, serviceActionID, productID, provisioningArtifactID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.ProvisioningArtifact.Id), provisioningArtifactID; got != want {
+		t.Errorf("provisioning artifact ID = %s, want %s", got, want)
+	}
+	if got, want := len(inputs), 2; got != want {
+		t.Fatalf("ListProvisioningArtifactsForServiceAction calls = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(inputs[1].PageToken), "page-1"; got != want {
+		t.Errorf("ListProvisioningArtifactsForServiceAction page token = %s, want %s", got, want)
+	}
+}
+
+func TestFindProvisioningArtifactsForServiceAction_pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	var inputs []*servicecatalog.ListProvisioningArtifactsForServiceActionInput
+
+	conn := newMockListProvisioningArtifactsForServiceActionClient(&inputs, [][]awstypes.ProvisioningArtifactView{
+		{
+			{ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String("pa-1")}},
+			{ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String("pa-2")}},
+		},
+		{
+			{ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String("pa-3")}},
+		},
+	})
+
+	output, err := tfservicecatalog.FindProvisioningArtifactsForServiceAction(ctx, conn, tfservicecatalog.AcceptLanguageEnglish, "act-abcdefghijklm")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, v := range output {
+		got = append(got, aws.ToString(v.ProvisioningArtifact.Id))
+	}
+	if want := []string{"pa-1", "pa-2", "pa-3"}; !slices.Equal(got, want) {
+		t.Errorf("provisioning artifact IDs = %v, want %v", got, want)
+	}
+	if got, want := len(inputs), 2; got != want {
+		t.Errorf("ListProvisioningArtifactsForServiceAction calls = %d, want %d", got, want)
+	}
+}
+
+// newMockListProvisioningArtifactsForServiceActionClient returns a client whose ListProvisioningArtifactsForServiceAction
+// returns the specified pages in order, recording each request in inputs.
+func newMockListProvisioningArtifactsForServiceActionClient(inputs *[]*servicecatalog.ListProvisioningArtifactsForServiceActionInput, pages [][]awstypes.ProvisioningArtifactView) *servicecatalog.Client {
+	return newMockClient(func(_ context.Context, input any) (any, error) {
+		if v, ok := input.(*servicecatalog.ListProvisioningArtifactsForServiceActionInput); ok {
+			*inputs = append(*inputs, v)
+
+			i := 0
+			if v.PageToken != nil {
+				if _, err := fmt.Sscanf(aws.ToString(v.PageToken), "page-%d", &i); err != nil {
+					return nil, err
+				}
+			}
+
+			output := &servicecatalog.ListProvisioningArtifactsForServiceActionOutput{
+				ProvisioningArtifactViews: pages[i],
+			}
+			if i+1 < len(pages) {
+				output.NextPageToken = aws.String(fmt.Sprintf("page-%d", i+1))
+			}
+
+			return output, nil
+		}
+		return nil, fmt.Errorf("unexpected operation input: %T", input)
+	})
+}
+
+func TestAccServiceCatalogServiceActionAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionAssociationConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", tfservicecatalog.AcceptLanguageEnglish),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "product_name", "aws_servicecatalog_product.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_artifact_id", "aws_servicecatalog_provisioning_artifact.test", "provisioning_artifact_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_artifact_name", "aws_servicecatalog_provisioning_artifact.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_action_id", "aws_servicecatalog_service_action.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "service_action_name", "aws_servicecatalog_service_action.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccServiceActionAssociationImportStateIdFunc(resourceName, tfservicecatalog.AcceptLanguageEnglish),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "act-123456789012,prod-123456789012",
+				ExpectError:   regexache.MustCompile(`unexpected format of import ID`),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogServiceActionAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionAssociationConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfservicecatalog.ResourceServiceActionAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestServiceActionAssociationParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName                       string
+		InputID                        string
+		ExpectError                    bool
+		ExpectedAcceptLanguage         string
+		ExpectedServiceActionID        string
+		ExpectedProductID              string
+		ExpectedProvisioningArtifactID string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too few parts",
+			InputID:     "act-123,prod-456",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "act-123,prod-456,pa-789,en,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty part",
+			InputID:     "act-123,,pa-789",
+			ExpectError: true,
+		},
+		{
+			TestName:    "invalid accept language",
+			InputID:     "act-123,prod-456,pa-789,fr",
+			ExpectError: true,
+		},
+		{
+			TestName:                       "default accept language",
+			InputID:                        "act-123,prod-456,pa-789",
+			ExpectedAcceptLanguage:         "",
+			ExpectedServiceActionID:        "act-123",
+			ExpectedProductID:              "prod-456",
+			ExpectedProvisioningArtifactID: "pa-789",
+		},
+		{
+			TestName:                       "explicit accept language",
+			InputID:                        "act-123,prod-456,pa-789,jp",
+			ExpectedAcceptLanguage:         "jp",
+			ExpectedServiceActionID:        "act-123",
+			ExpectedProductID:              "prod-456",
+			ExpectedProvisioningArtifactID: "pa-789",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			acceptLanguage, serviceActionID, productID, provisioningArtifactID, err := tfservicecatalog.ServiceActionAssociationParseImportID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if acceptLanguage != testCase.ExpectedAcceptLanguage {
+				t.Errorf("acceptLanguage = %q, want %q", acceptLanguage, testCase.ExpectedAcceptLanguage)
+			}
+
+			if serviceActionID != testCase.ExpectedServiceActionID {
+				t.Errorf("serviceActionID = %q, want %q", serviceActionID, testCase.ExpectedServiceActionID)
+			}
+
+			if productID != testCase.ExpectedProductID {
+				t.Errorf("productID = %q, want %q", productID, testCase.ExpectedProductID)
+			}
+
+			if provisioningArtifactID != testCase.ExpectedProvisioningArtifactID {
+				t.Errorf("provisioningArtifactID = %q, want %q", provisioningArtifactID, testCase.ExpectedProvisioningArtifactID)
+			}
+		})
+	}
+}
+
+func testAccCheckServiceActionAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_servicecatalog_service_action_association" {
+				continue
+			}
+
+			serviceActionID, productID, provisioningArtifactID, err := tfservicecatalog.ServiceActionAssociationParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfservicecatalog.FindServiceActionAssociation(ctx, conn, rs.Primary.Attributes["accept_language"], serviceActionID, productID, provisioningArtifactID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Service Catalog Service Action Association (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceActionAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		serviceActionID, productID, provisioningArtifactID, err := tfservicecatalog.ServiceActionAssociationParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+		_, err = tfservicecatalog.FindServiceActionAssociation(ctx, conn, rs.Primary.Attributes["accept_language"], serviceActionID, productID, provisioningArtifactID)
+
+		return err
+	}
+}
+
+func testAccServiceActionAssociationImportStateIdFunc(n, acceptLanguage string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s,%s,%s", rs.Primary.Attributes["service_action_id"], rs.Primary.Attributes["product_id"], rs.Primary.Attributes["provisioning_artifact_id"], acceptLanguage), nil
+	}
+}
+
+func testAccServiceActionAssociationConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(
+		testAccProvisioningArtifactConfig_basic(rName, domain),
+		testAccServiceActionConfig_basic(rName),
+		`
+resource "aws_servicecatalog_service_action_association" "test" {
+  product_id               = aws_servicecatalog_product.test.id
+  provisioning_artifact_id = aws_servicecatalog_provisioning_artifact.test.provisioning_artifact_id
+  service_action_id        = aws_servicecatalog_service_action.test.id
+}
+`)
+}
@@ -5,9 +5,12 @@ package servicecatalog_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
@@ -41,6 +44,8 @@ func TestAccServiceCatalogServiceAction_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "accept_language", tfservicecatalog.AcceptLanguageEnglish),
 					resource.TestCheckResourceAttr(resourceName, "definition.0.name", "AWS-RestartEC2Instance"),
 					resource.TestCheckResourceAttr(resourceName, "definition.0.version", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "definition_type", resourceName, "definition.0.type"),
+					resource.TestCheckResourceAttr(resourceName, "definition_type", string(awstypes.ServiceActionDefinitionTypeSsmAutomation)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 				),
@@ -116,6 +121,319 @@ func TestAccServiceCatalogServiceAction_update(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogServiceAction_definitionParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionConfig_definitionParameters(rName, "TARGET"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					testAccCheckServiceActionDefinition(ctx, resourceName, map[awstypes.ServiceActionDefinitionKey]string{
+						awstypes.ServiceActionDefinitionKeyName:    "AWS-RestartEC2Instance",
+						awstypes.ServiceActionDefinitionKeyVersion: "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.parameters", `[{"Name":"InstanceId","Type":"TARGET"}]`),
+				),
+			},
+			{
+				Config: testAccServiceActionConfig_definitionParameters(rName, "TEXT_VALUE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					testAccCheckServiceActionDefinition(ctx, resourceName, map[awstypes.ServiceActionDefinitionKey]string{
+						awstypes.ServiceActionDefinitionKeyName:    "AWS-RestartEC2Instance",
+						awstypes.ServiceActionDefinitionKeyVersion: "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.name", "AWS-RestartEC2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.parameters", `[{"Name":"InstanceId","Type":"TEXT_VALUE"}]`),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.type", string(awstypes.ServiceActionDefinitionTypeSsmAutomation)),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.version", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogServiceAction_definitionParametersDefaultValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionConfig_definitionParametersDefaultValue(rName, "i-0123456789abcdef0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					testAccCheckServiceActionDefinition(ctx, resourceName, map[awstypes.ServiceActionDefinitionKey]string{
+						awstypes.ServiceActionDefinitionKeyParameters: `[{"DefaultValue":"i-0123456789abcdef0","Name":"InstanceId","Type":"TEXT_VALUE"}]`,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.parameters", `[{"DefaultValue":"i-0123456789abcdef0","Name":"InstanceId","Type":"TEXT_VALUE"}]`),
+				),
+			},
+			{
+				Config: testAccServiceActionConfig_definitionParametersDefaultValue(rName, "i-0fedcba9876543210"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.parameters", `[{"DefaultValue":"i-0fedcba9876543210","Name":"InstanceId","Type":"TEXT_VALUE"}]`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"accept_language",
+				},
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogServiceAction_assumeRoleLaunch(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionConfig_assumeRoleLaunch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.assume_role", "LAUNCH_ROLE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"accept_language",
+				},
+			},
+			{
+				Config: testAccServiceActionConfig_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.assume_role", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogServiceAction_acceptLanguage(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionConfig_acceptLanguage(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", "zh"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+				),
+			},
+			{
+				Config: testAccServiceActionConfig_acceptLanguage(rName, fmt.Sprintf("%s-2", rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", "zh"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, fmt.Sprintf("%s-2", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogServiceAction_providerAcceptLanguage(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionConfig_providerAcceptLanguage(rName, "zh"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", "zh"),
+				),
+			},
+			{
+				// Changing the provider-level default does not affect existing resources.
+				Config:   testAccServiceActionConfig_providerAcceptLanguage(rName, tfservicecatalog.AcceptLanguageEnglish),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogServiceAction_readAssociations(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_service_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionAssociationConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "number_of_associations"),
+				),
+			},
+			{
+				Config: testAccServiceActionConfig_readAssociations(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "number_of_associations", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "read_associations", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"accept_language",
+					"number_of_associations",
+					"read_associations",
+				},
+			},
+		},
+	})
+}
+
+func TestServiceActionDefinition_parametersRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const parameters = `[{"Name":"InstanceId","Type":"TEXT_VALUE","DefaultValue":"i-0123456789abcdef0"}]`
+
+	tfMap := map[string]interface{}{
+		names.AttrName:       "AWS-RestartEC2Instance",
+		names.AttrParameters: parameters,
+		names.AttrVersion:    "1",
+	}
+
+	apiObject := tfservicecatalog.ExpandServiceActionDefinition(tfMap)
+
+	if got := apiObject[string(awstypes.ServiceActionDefinitionKeyParameters)]; got != parameters {
+		t.Errorf("expanded parameters = %q, want %q", got, parameters)
+	}
+
+	got := tfservicecatalog.FlattenServiceActionDefinition(apiObject, awstypes.ServiceActionDefinitionTypeSsmAutomation)
+
+	if got[names.AttrParameters] != parameters {
+		t.Errorf("flattened parameters = %q, want %q", got[names.AttrParameters], parameters)
+	}
+}
+
+func TestUpdateServiceAction_associated(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const serviceActionID = "act-abcdefghijklm"
+
+	testCases := map[string]struct {
+		updateErr    error
+		associations []awstypes.ProvisioningArtifactView
+		wantAdvice   bool
+	}{
+		"associated": {
+			updateErr:    &awstypes.InvalidParametersException{Message: aws.String("Service action is associated with one or more provisioning artifacts")},
+			associations: []awstypes.ProvisioningArtifactView{{ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String("pa-1")}}},
+			wantAdvice:   true,
+		},
+		"associated, undocumented message": {
+			updateErr:    &awstypes.ResourceInUseException{Message: aws.String("Cannot update the service action")},
+			associations: []awstypes.ProvisioningArtifactView{{ProvisioningArtifact: &awstypes.ProvisioningArtifact{Id: aws.String("pa-1")}}},
+			wantAdvice:   true,
+		},
+		"not associated": {
+			updateErr: &awstypes.InvalidParametersException{Message: aws.String("Name is associated with another service action")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The mock rejects any name change.
+			conn := newMockClient(func(_ context.Context, input any) (any, error) {
+				switch v := input.(type) {
+				case *servicecatalog.UpdateServiceActionInput:
+					if v.Name != nil {
+						return nil, testCase.updateErr
+					}
+					return &servicecatalog.UpdateServiceActionOutput{}, nil
+				case *servicecatalog.ListProvisioningArtifactsForServiceActionInput:
+					return &servicecatalog.ListProvisioningArtifactsForServiceActionOutput{
+						ProvisioningArtifactViews: testCase.associations,
+					}, nil
+				}
+				return nil, fmt.Errorf("unexpected operation input: %T", input)
+			})
+
+			err := tfservicecatalog.UpdateServiceAction(ctx, conn, &servicecatalog.UpdateServiceActionInput{
+				Id:   aws.String(serviceActionID),
+				Name: aws.String("renamed"),
+			}, time.Minute)
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got := regexache.MustCompile(`cannot be renamed while it is associated.*Disassociate it first`).MatchString(err.Error()); got != testCase.wantAdvice {
+				t.Errorf("error = %q, want disassociation advice: %t", err, testCase.wantAdvice)
+			}
+			if !errors.Is(err, testCase.updateErr) {
+				t.Errorf("error = %q, want it to wrap the API error", err)
+			}
+
+			err = tfservicecatalog.UpdateServiceAction(ctx, conn, &servicecatalog.UpdateServiceActionInput{
+				Description: aws.String("updated"),
+				Id:          aws.String(serviceActionID),
+			}, time.Minute)
+
+			if err != nil {
+				t.Errorf("unexpected error updating description: %s", err)
+			}
+		})
+	}
+}
+
 func testAccCheckServiceActionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -172,6 +490,41 @@ func testAccCheckServiceActionExists(ctx context.Context, resourceName string) r
 	}
 }
 
+// testAccCheckServiceActionDefinition verifies the service action's definition in AWS contains the expected values.
+func testAccCheckServiceActionDefinition(ctx context.Context, resourceName string, expected map[awstypes.ServiceActionDefinitionKey]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+		input := &servicecatalog.DescribeServiceActionInput{
+			Id: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.DescribeServiceAction(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error describing Service Catalog Service Action (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output.ServiceActionDetail == nil {
+			return fmt.Errorf("Service Catalog Service Action (%s) has no detail", rs.Primary.ID)
+		}
+
+		for k, want := range expected {
+			if got := output.ServiceActionDetail.Definition[string(k)]; got != want {
+				return fmt.Errorf("Service Catalog Service Action (%s) definition %s = %q, want %q", rs.Primary.ID, k, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccServiceActionConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_servicecatalog_service_action" "test" {
@@ -187,6 +540,61 @@ resource "aws_servicecatalog_service_action" "test" {
 `, rName)
 }
 
+func testAccServiceActionConfig_readAssociations(rName, domain string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_service_action" "test" {
+  accept_language   = "en"
+  description       = %[1]q
+  name              = %[1]q
+  read_associations = true
+
+  definition {
+    name    = "AWS-RestartEC2Instance"
+    version = "1"
+  }
+}
+
+resource "aws_servicecatalog_service_action_association" "test" {
+  product_id               = aws_servicecatalog_product.test.id
+  provisioning_artifact_id = aws_servicecatalog_provisioning_artifact.test.provisioning_artifact_id
+  service_action_id        = aws_servicecatalog_service_action.test.id
+}
+`, rName))
+}
+
+func testAccServiceActionConfig_acceptLanguage(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_service_action" "test" {
+  accept_language = "zh"
+  description     = %[2]q
+  name            = %[1]q
+
+  definition {
+    name    = "AWS-RestartEC2Instance"
+    version = "1"
+  }
+}
+`, rName, description)
+}
+
+func testAccServiceActionConfig_providerAcceptLanguage(rName, acceptLanguage string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  servicecatalog_accept_language = %[2]q
+}
+
+resource "aws_servicecatalog_service_action" "test" {
+  description = %[1]q
+  name        = %[1]q
+
+  definition {
+    name    = "AWS-RestartEC2Instance"
+    version = "1"
+  }
+}
+`, rName, acceptLanguage)
+}
+
 func testAccServiceActionConfig_update(rName string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
@@ -228,3 +636,48 @@ resource "aws_servicecatalog_service_action" "test" {
 }
 `, rName)
 }
+
+func testAccServiceActionConfig_assumeRoleLaunch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_service_action" "test" {
+  description = %[1]q
+  name        = %[1]q
+
+  definition {
+    assume_role = "LAUNCH_ROLE"
+    name        = "AWS-RestartEC2Instance"
+    version     = "1"
+  }
+}
+`, rName)
+}
+
+func testAccServiceActionConfig_definitionParameters(rName, parameterType string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_service_action" "test" {
+  description = %[1]q
+  name        = %[1]q
+
+  definition {
+    name       = "AWS-RestartEC2Instance"
+    parameters = jsonencode([{ Name = "InstanceId", Type = %[2]q }])
+    version    = "1"
+  }
+}
+`, rName, parameterType)
+}
+
+func testAccServiceActionConfig_definitionParametersDefaultValue(rName, defaultValue string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_service_action" "test" {
+  description = %[1]q
+  name        = %[1]q
+
+  definition {
+    name       = "AWS-RestartEC2Instance"
+    parameters = jsonencode([{ Name = "InstanceId", Type = "TEXT_VALUE", DefaultValue = %[2]q }])
+    version    = "1"
+  }
+}
+`, rName, defaultValue)
+}
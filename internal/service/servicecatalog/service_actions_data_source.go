@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_servicecatalog_service_actions", name="Service Actions")
+func dataSourceServiceActions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceActionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(acceptLanguage_Values(), false),
+			},
+			"service_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"definition_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceActionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	acceptLanguage := acceptLanguageOrDefault(ctx, d, meta)
+	summaries, err := findServiceActions(ctx, conn, acceptLanguage)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Service Catalog Service Actions: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("accept_language", acceptLanguage)
+	if err := d.Set("service_actions", flattenServiceActionSummaries(summaries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service_actions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenServiceActionSummaries(apiObjects []awstypes.ServiceActionSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenServiceActionSummary(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenServiceActionSummary(apiObject awstypes.ServiceActionSummary) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"definition_type": string(apiObject.DefinitionType),
+	}
+
+	if apiObject.Description != nil {
+		tfMap[names.AttrDescription] = aws.ToString(apiObject.Description)
+	}
+	if apiObject.Id != nil {
+		tfMap[names.AttrID] = aws.ToString(apiObject.Id)
+	}
+	if apiObject.Name != nil {
+		tfMap[names.AttrName] = aws.ToString(apiObject.Name)
+	}
+
+	return tfMap
+}
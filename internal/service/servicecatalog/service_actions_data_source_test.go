@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogServiceActionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicecatalog_service_actions.test"
+	resourceName := "aws_servicecatalog_service_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceActionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accept_language", tfservicecatalog.AcceptLanguageEnglish),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "service_actions.#", 1),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "service_actions.*", map[string]string{
+						"definition_type":     string(awstypes.ServiceActionDefinitionTypeSsmAutomation),
+						names.AttrDescription: rName,
+						names.AttrName:        rName,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "service_actions.*.id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceActionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceActionConfig_basic(rName), `
+data "aws_servicecatalog_service_actions" "test" {
+  depends_on = [aws_servicecatalog_service_action.test]
+}
+`)
+}
@@ -51,11 +51,31 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Product",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceProductConstraints,
+			TypeName: "aws_servicecatalog_product_constraints",
+			Name:     "Product Constraints",
+		},
+		{
+			Factory:  dataSourceProvisionedProductPlanPreview,
+			TypeName: "aws_servicecatalog_provisioned_product_plan_preview",
+			Name:     "Provisioned Product Plan Preview",
+		},
 		{
 			Factory:  dataSourceProvisioningArtifacts,
 			TypeName: "aws_servicecatalog_provisioning_artifacts",
 			Name:     "Provisioning Artifacts",
 		},
+		{
+			Factory:  dataSourceServiceActions,
+			TypeName: "aws_servicecatalog_service_actions",
+			Name:     "Service Actions",
+		},
+		{
+			Factory:  dataSourceTagOptions,
+			TypeName: "aws_servicecatalog_tag_options",
+			Name:     "Tag Options",
+		},
 	}
 }
 
@@ -119,6 +139,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_servicecatalog_service_action",
 			Name:     "Service Action",
 		},
+		{
+			Factory:  resourceServiceActionAssociation,
+			TypeName: "aws_servicecatalog_service_action_association",
+			Name:     "Service Action Association",
+		},
 		{
 			Factory:  resourceTagOption,
 			TypeName: "aws_servicecatalog_tag_option",
@@ -6,6 +6,7 @@ package servicecatalog_test
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
@@ -26,3 +27,11 @@ func TestAccServiceCatalog_serial(t *testing.T) {
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
 }
+
+// newMockClient returns a client whose operations are answered by handler without sending any requests.
+func newMockClient(handler acctest.MockHandler) *servicecatalog.Client {
+	return servicecatalog.New(servicecatalog.Options{
+		Region:     acctest.MockRegion,
+		APIOptions: acctest.MockAPIOptions(handler),
+	})
+}
@@ -172,7 +172,8 @@ func statusProductPortfolioAssociation(ctx context.Context, conn *servicecatalog
 	return func() (interface{}, string, error) {
 		output, err := findProductPortfolioAssociation(ctx, conn, acceptLanguage, portfolioID, productID)
 
-		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		// The product no longer exists or the portfolio is no longer returned for the product.
+		if tfresource.NotFound(err) {
 			return nil, statusNotFound, &retry.NotFoundError{
 				Message: fmt.Sprintf("product portfolio association not found (%s): %s", productPortfolioAssociationCreateID(acceptLanguage, portfolioID, productID), err),
 			}
@@ -354,6 +355,22 @@ func statusProvisionedProduct(ctx context.Context, conn *servicecatalog.Client,
 	}
 }
 
+func statusProvisionedProductPlan(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findProvisionedProductPlanByID(ctx, conn, acceptLanguage, planID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ProvisionedProductPlanDetails.Status), nil
+	}
+}
+
 func statusPortfolioConstraints(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, portfolioID, productID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &servicecatalog.ListConstraintsForPortfolioInput{
@@ -164,6 +164,55 @@ func TestAccServiceCatalogTagOption_notActive(t *testing.T) {
 	})
 }
 
+func TestAccServiceCatalogTagOption_activeDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_tag_option.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagOptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagOptionConfig_basic(rName, "värde", "active = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagOptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtTrue),
+					// Deactivate the tag option outside Terraform.
+					testAccCheckTagOptionUpdateActive(ctx, resourceName, false),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTagOptionConfig_basic(rName, "värde", "active = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagOptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccTagOptionConfig_basic(rName, "värde", "active = false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagOptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtFalse),
+					// Reactivate the tag option outside Terraform.
+					testAccCheckTagOptionUpdateActive(ctx, resourceName, true),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTagOptionConfig_basic(rName, "värde", "active = false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagOptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTagOptionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
@@ -220,6 +269,31 @@ func testAccCheckTagOptionExists(ctx context.Context, resourceName string) resou
 	}
 }
 
+func testAccCheckTagOptionUpdateActive(ctx context.Context, resourceName string, active bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+		input := &servicecatalog.UpdateTagOptionInput{
+			Active: aws.Bool(active),
+			Id:     aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.UpdateTagOption(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Service Catalog Tag Option (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccTagOptionConfig_basic(key, value, active string) string {
 	return fmt.Sprintf(`
 resource "aws_servicecatalog_tag_option" "test" {
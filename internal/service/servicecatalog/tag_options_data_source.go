@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_servicecatalog_tag_options", name="Tag Options")
+func dataSourceTagOptions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTagOptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrKey: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrOwner: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrValue: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceTagOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	filters := &awstypes.ListTagOptionsFilters{}
+
+	if v := d.GetRawConfig().GetAttr("active"); !v.IsNull() {
+		filters.Active = aws.Bool(v.True())
+	}
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		filters.Key = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrValue); ok {
+		filters.Value = aws.String(v.(string))
+	}
+
+	tagOptions, err := findTagOptions(ctx, conn, filters)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Service Catalog Tag Options: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("tag_options", flattenTagOptionDetails(tagOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tag_options: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTagOptionDetails(apiObjects []awstypes.TagOptionDetail) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenTagOptionDetail(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTagOptionDetail(apiObject awstypes.TagOptionDetail) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"active": aws.ToBool(apiObject.Active),
+	}
+
+	if apiObject.Id != nil {
+		tfMap[names.AttrID] = aws.ToString(apiObject.Id)
+	}
+	if apiObject.Key != nil {
+		tfMap[names.AttrKey] = aws.ToString(apiObject.Key)
+	}
+	if apiObject.Owner != nil {
+		tfMap[names.AttrOwner] = aws.ToString(apiObject.Owner)
+	}
+	if apiObject.Value != nil {
+		tfMap[names.AttrValue] = aws.ToString(apiObject.Value)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogTagOptionsDataSource_key(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicecatalog_tag_options.test"
+	resourceName := "aws_servicecatalog_tag_option.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagOptionsDataSourceConfig_key(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tag_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tag_options.0.active", resourceName, "active"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tag_options.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tag_options.0.key", resourceName, names.AttrKey),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tag_options.0.value", resourceName, names.AttrValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogTagOptionsDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicecatalog_tag_options.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagOptionsDataSourceConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tag_options.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagOptionsDataSourceConfig_key(rName string) string {
+	return acctest.ConfigCompose(testAccTagOptionConfig_basic(rName, "värde", ""), `
+data "aws_servicecatalog_tag_options" "test" {
+  key    = aws_servicecatalog_tag_option.test.key
+  active = true
+}
+`)
+}
+
+func testAccTagOptionsDataSourceConfig_empty(rName string) string {
+	return fmt.Sprintf(`
+data "aws_servicecatalog_tag_options" "test" {
+  key = %[1]q
+}
+`, rName)
+}
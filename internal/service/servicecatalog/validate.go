@@ -4,9 +4,15 @@
 package servicecatalog
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -35,3 +41,109 @@ func validSharePrincipal(v interface{}, k string) (ws []string, errors []error)
 
 	return ws, errors
 }
+
+// validServiceActionParameters ensures that each SSM automation parameter in a service action
+// definition's parameters JSON array has a known Type. Invalid JSON is reported by validation.StringIsJSON.
+func validServiceActionParameters(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	var parameters []map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &parameters); err != nil {
+		return
+	}
+
+	for i, parameter := range parameters {
+		v, ok := parameter["Type"]
+		if !ok {
+			continue
+		}
+
+		if parameterType, ok := v.(string); !ok || !slices.Contains(serviceActionParameterType_Values(), parameterType) {
+			errors = append(errors, fmt.Errorf("expected %s[%d].Type to be one of %q, got: %v", k, i, serviceActionParameterType_Values(), v))
+		}
+	}
+
+	return
+}
+
+// validServiceActionAssumeRole ensures that a service action definition's AssumeRole is either an IAM role ARN
+// or LAUNCH_ROLE, which uses the provisioned product's launch role. Other values only fail when the action is executed.
+var validServiceActionAssumeRole = validation.Any(
+	validation.StringInSlice([]string{serviceActionAssumeRoleLaunch}, false),
+	verify.ValidARNCheck(iamRoleARNCheck),
+)
+
+func iamRoleARNCheck(v any, k string, parsedARN arn.ARN) (ws []string, errors []error) {
+	if parsedARN.Service != "iam" || !strings.HasPrefix(parsedARN.Resource, "role/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid IAM role ARN", k, v))
+	}
+
+	return
+}
+
+// validateLaunchConstraintParameters ensures that the parameters JSON of a LAUNCH constraint
+// specifies exactly one of RoleArn or LocalRoleName. Invalid JSON is reported by validation.StringIsJSON.
+func validateLaunchConstraintParameters(v string) error {
+	var parameters map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &parameters); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil
+		}
+
+		return fmt.Errorf("%s constraint parameters must be a JSON object", constraintTypeLaunch)
+	}
+
+	_, hasRoleARN := parameters["RoleArn"]
+	_, hasLocalRoleName := parameters["LocalRoleName"]
+
+	switch {
+	case hasRoleARN && hasLocalRoleName:
+		return fmt.Errorf("%s constraint parameters must specify only one of RoleArn or LocalRoleName, got both", constraintTypeLaunch)
+	case !hasRoleARN && !hasLocalRoleName:
+		return fmt.Errorf("%s constraint parameters must specify one of RoleArn or LocalRoleName", constraintTypeLaunch)
+	}
+
+	return nil
+}
+
+// validateNotificationTopicARN ensures that a notification ARN is an SNS topic ARN in the specified Region.
+// CloudFormation only publishes stack events to topics in the stack's Region.
+func validateNotificationTopicARN(topicARN, region string) error {
+	v, err := arn.Parse(topicARN)
+	if err != nil {
+		return fmt.Errorf("notification ARN (%s) is invalid: %w", topicARN, err)
+	}
+
+	if v.Service != "sns" {
+		return fmt.Errorf("notification ARN (%s) is not an SNS topic ARN", topicARN)
+	}
+
+	if v.Region != region {
+		return fmt.Errorf("notification SNS topic (%s) is in Region %q, expected %q", topicARN, v.Region, region)
+	}
+
+	return nil
+}
+
+// notificationConstraintTopicARNs returns the SNS topic ARNs in the parameters JSON of a NOTIFICATION constraint.
+// Invalid JSON is reported by validation.StringIsJSON.
+func notificationConstraintTopicARNs(v string) ([]string, error) {
+	var parameters struct {
+		NotificationARNs []string `json:"NotificationArns"`
+	}
+	if err := json.Unmarshal([]byte(v), &parameters); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("%s constraint parameters must be a JSON object with a NotificationArns list of strings", constraintTypeNotification)
+	}
+
+	return parameters.NotificationARNs, nil
+}
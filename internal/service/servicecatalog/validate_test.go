@@ -4,6 +4,7 @@
 package servicecatalog
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -62,3 +63,238 @@ func TestValidSharePrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestValidServiceActionParameters(t *testing.T) {
+	t.Parallel()
+
+	validParameters := []string{
+		`[]`,
+		`[{"Name":"InstanceId","Type":"TARGET"}]`,
+		`[{"Name":"InstanceId","Type":"TARGET"},{"Name":"Comment","Type":"TEXT_VALUE"}]`,
+		`[{"Name":"InstanceId"}]`,
+		`{"Name":"InstanceId","Type":"TARGET"}`, // Not a parameter list, left to the API to validate.
+	}
+	for _, v := range validParameters {
+		_, errors := validServiceActionParameters(v, names.AttrParameters)
+		if len(errors) != 0 {
+			t.Errorf("%q should be valid service action parameters: %q", v, errors)
+		}
+	}
+
+	invalidParameters := []string{
+		`[{"Name":"InstanceId","Type":"TARGETS"}]`,
+		`[{"Name":"InstanceId","Type":"target"}]`,
+		`[{"Name":"InstanceId","Type":"TARGET"},{"Name":"Comment","Type":"TEXT"}]`,
+		`[{"Name":"InstanceId","Type":1}]`,
+	}
+	for _, v := range invalidParameters {
+		_, errors := validServiceActionParameters(v, names.AttrParameters)
+		if len(errors) == 0 {
+			t.Errorf("%q should be invalid service action parameters", v)
+		}
+	}
+}
+
+func TestValidServiceActionAssumeRole(t *testing.T) {
+	t.Parallel()
+
+	validAssumeRoles := []string{
+		"LAUNCH_ROLE",
+		"arn:aws:iam::123456789012:role/ServiceActionRole",              // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/ServiceActionRole", // lintignore:AWSAT005
+	}
+	for _, v := range validAssumeRoles {
+		_, errors := validServiceActionAssumeRole(v, "assume_role")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid service action assume role: %q", v, errors)
+		}
+	}
+
+	invalidAssumeRoles := []string{
+		"LAUNCH",
+		"launch_role",
+		"ServiceActionRole",
+		"arn:aws:iam::123456789012:user/ServiceActionUser",    // lintignore:AWSAT005
+		"arn:aws:s3:::bucket/role/ServiceActionRole",          // lintignore:AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/Role/Session", // lintignore:AWSAT005
+	}
+	for _, v := range invalidAssumeRoles {
+		_, errors := validServiceActionAssumeRole(v, "assume_role")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid service action assume role", v)
+		}
+	}
+}
+
+func TestValidateLaunchConstraintParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Input         string
+		ExpectedError string
+	}{
+		{
+			TestName: "role ARN",
+			Input:    `{"RoleArn":"arn:aws:iam::123456789012:role/LaunchRole"}`, // lintignore:AWSAT005
+		},
+		{
+			TestName: "local role name",
+			Input:    `{"LocalRoleName":"LaunchRole"}`,
+		},
+		{
+			TestName:      "both",
+			Input:         `{"LocalRoleName":"LaunchRole","RoleArn":"arn:aws:iam::123456789012:role/LaunchRole"}`, // lintignore:AWSAT005
+			ExpectedError: "LAUNCH constraint parameters must specify only one of RoleArn or LocalRoleName, got both",
+		},
+		{
+			TestName:      "neither",
+			Input:         `{"Description":"LaunchRole"}`,
+			ExpectedError: "LAUNCH constraint parameters must specify one of RoleArn or LocalRoleName",
+		},
+		{
+			TestName:      "not an object",
+			Input:         `[{"RoleArn":"arn:aws:iam::123456789012:role/LaunchRole"}]`, // lintignore:AWSAT005
+			ExpectedError: "LAUNCH constraint parameters must be a JSON object",
+		},
+		{
+			TestName: "invalid JSON",
+			Input:    `{"RoleArn":`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateLaunchConstraintParameters(testCase.Input)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q", testCase.ExpectedError)
+			}
+
+			if got, want := err.Error(), testCase.ExpectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateNotificationTopicARN(t *testing.T) {
+	t.Parallel()
+
+	const region = "us-west-2" //lintignore:AWSAT003
+
+	testCases := []struct {
+		TestName      string
+		Input         string
+		ExpectedError string
+	}{
+		{
+			TestName: "same Region",
+			Input:    "arn:aws:sns:us-west-2:123456789012:topic", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "different Region",
+			Input:         "arn:aws:sns:us-east-1:123456789012:topic",                                                                         //lintignore:AWSAT003,AWSAT005
+			ExpectedError: `notification SNS topic (arn:aws:sns:us-east-1:123456789012:topic) is in Region "us-east-1", expected "us-west-2"`, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "not SNS",
+			Input:         "arn:aws:sqs:us-west-2:123456789012:queue",                                            //lintignore:AWSAT003,AWSAT005
+			ExpectedError: "notification ARN (arn:aws:sqs:us-west-2:123456789012:queue) is not an SNS topic ARN", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "not an ARN",
+			Input:         "topic",
+			ExpectedError: "notification ARN (topic) is invalid: arn: invalid prefix",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateNotificationTopicARN(testCase.Input, region)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q", testCase.ExpectedError)
+			}
+
+			if got, want := err.Error(), testCase.ExpectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNotificationConstraintTopicARNs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Input         string
+		Expected      []string
+		ExpectedError string
+	}{
+		{
+			TestName: "topics",
+			Input:    `{"NotificationArns":["arn:aws:sns:us-west-2:123456789012:topic1","arn:aws:sns:us-west-2:123456789012:topic2"]}`, //lintignore:AWSAT003,AWSAT005
+			Expected: []string{
+				"arn:aws:sns:us-west-2:123456789012:topic1", //lintignore:AWSAT003,AWSAT005
+				"arn:aws:sns:us-west-2:123456789012:topic2", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		{
+			TestName: "no topics",
+			Input:    `{}`,
+		},
+		{
+			TestName:      "not a list",
+			Input:         `{"NotificationArns":"arn:aws:sns:us-west-2:123456789012:topic"}`, //lintignore:AWSAT003,AWSAT005
+			ExpectedError: "NOTIFICATION constraint parameters must be a JSON object with a NotificationArns list of strings",
+		},
+		{
+			TestName: "invalid JSON",
+			Input:    `{"NotificationArns":`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := notificationConstraintTopicARNs(testCase.Input)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Fatalf("expected error %q", testCase.ExpectedError)
+			} else if got, want := err.Error(), testCase.ExpectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+
+			if !slices.Equal(got, testCase.Expected) {
+				t.Errorf("topic ARNs = %q, want %q", got, testCase.Expected)
+			}
+		})
+	}
+}
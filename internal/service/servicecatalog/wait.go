@@ -6,6 +6,7 @@ package servicecatalog
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -43,6 +45,7 @@ const (
 	ProductReadyTimeout                       = 5 * time.Minute
 	ProductUpdateTimeout                      = 5 * time.Minute
 	ProvisionedProductDeleteTimeout           = 30 * time.Minute
+	ProvisionedProductPlanReadyTimeout        = 10 * time.Minute
 	ProvisionedProductReadTimeout             = 10 * time.Minute
 	ProvisionedProductReadyTimeout            = 30 * time.Minute
 	ProvisionedProductUpdateTimeout           = 30 * time.Minute
@@ -421,6 +424,11 @@ func waitProvisioningArtifactReady(ctx context.Context, conn *servicecatalog.Cli
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
+		// DescribeProvisioningArtifact has no status message, so a failed artifact is described by its info.
+		if output.Status == awstypes.StatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("provisioning artifact (%s) info: %v", id, output.Info))
+		}
+
 		return output, err
 	}
 
@@ -508,6 +516,27 @@ func waitProvisionedProductTerminated(ctx context.Context, conn *servicecatalog.
 	return err
 }
 
+func waitProvisionedProductPlanReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, planID string, timeout time.Duration) (*servicecatalog.DescribeProvisionedProductPlanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProvisionedProductPlanStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.ProvisionedProductPlanStatusCreateSuccess),
+		Refresh: statusProvisionedProductPlan(ctx, conn, acceptLanguage, planID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*servicecatalog.DescribeProvisionedProductPlanOutput); ok {
+		if output.ProvisionedProductPlanDetails.Status == awstypes.ProvisionedProductPlanStatusCreateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ProvisionedProductPlanDetails.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitPortfolioConstraintsReady(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, portfolioID, productID string, timeout time.Duration) ([]awstypes.ConstraintDetail, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{statusNotFound},
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+// Exports for use in other modules.
+var (
+	FindTopicAttributesByARN = findTopicAttributesByARN
+)